@@ -31,8 +31,12 @@
 package astra
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 )
 
 // Version information
@@ -198,12 +202,16 @@ func MarshalAct(act ConversationAct) ([]byte, error) {
 func UnmarshalAct(data []byte) (ConversationAct, error) {
 	// First, determine the type
 	var typeCheck struct {
-		Type ActType `json:"type"`
+		Type      ActType         `json:"type"`
+		Timestamp json.RawMessage `json:"timestamp"`
 	}
 	
 	if err := json.Unmarshal(data, &typeCheck); err != nil {
 		return nil, fmt.Errorf("failed to determine act type: %w", err)
 	}
+	if err := checkActTimestamp(typeCheck.Timestamp); err != nil {
+		return nil, err
+	}
 	
 	// Unmarshal to the specific type
 	switch typeCheck.Type {
@@ -232,6 +240,208 @@ func UnmarshalAct(data []byte) (ConversationAct, error) {
 	}
 }
 
+// checkActTimestamp checks an act's raw timestamp with ParseTimestamp, so that
+// a malformed timestamp is reported against its field instead of as a generic
+// decoding error. An absent timestamp is left to validation.
+func checkActTimestamp(raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var timestamp string
+	if err := json.Unmarshal(raw, &timestamp); err != nil {
+		return ValidationError{Field: "timestamp", Message: "timestamp must be a string", Value: string(raw)}
+	}
+	_, err := ParseTimestamp(timestamp)
+	return err
+}
+
+// checkActTimestampValue is checkActTimestamp for a timestamp already decoded
+// into a generic JSON value
+func checkActTimestampValue(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	timestamp, ok := value.(string)
+	if !ok {
+		raw, _ := json.Marshal(value)
+		return ValidationError{Field: "timestamp", Message: "timestamp must be a string", Value: string(raw)}
+	}
+	_, err := ParseTimestamp(timestamp)
+	return err
+}
+
+// MarshalConversation marshals a Conversation to JSON. When validate is true
+// the conversation is checked with the same rules as UnmarshalConversation and
+// nothing is returned if it is invalid.
+func MarshalConversation(c Conversation, validate bool) ([]byte, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	if validate {
+		var document interface{}
+		if err := json.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		if err := validateConversationDocument(context.Background(), c, document); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalConversation unmarshals JSON to a Conversation. When validate is
+// true the document is checked against the conversation schema and every act
+// is validated, acts must be in chronological order, and every speaker must be
+// a conversation participant. All problems found are returned joined together.
+func UnmarshalConversation(data []byte, validate bool) (Conversation, error) {
+	return UnmarshalConversationContext(context.Background(), data, validate)
+}
+
+// UnmarshalConversationContext is like UnmarshalConversation, but stops
+// validating acts once ctx is done and returns the context's error joined with
+// the problems found so far. Decoding itself is not interrupted.
+func UnmarshalConversationContext(ctx context.Context, data []byte, validate bool) (Conversation, error) {
+	var c Conversation
+	if err := ctx.Err(); err != nil {
+		return c, err
+	}
+	if !validate {
+		err := json.Unmarshal(data, &c)
+		return c, err
+	}
+
+	// Decode once into generic values for the schema, and build the
+	// conversation from them
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return c, err
+	}
+	c, err := decodeConversationDocument(document)
+	if err != nil {
+		return c, err
+	}
+	if err := validateConversationDocument(ctx, c, document); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// validateConversationDocument checks a decoded conversation document against
+// the conversation schema and the conversation's acts structurally
+func validateConversationDocument(ctx context.Context, c Conversation, document interface{}) error {
+	var errs []error
+	if err := validateAgainstSchema(document, Schemas.Conversation); err != nil {
+		errs = append(errs, fmt.Errorf("conversation schema: %w", err))
+	}
+	errs = append(errs, validateConversationActs(ctx, c)...)
+	return errors.Join(errs...)
+}
+
+// validateConversationActs validates every act in a conversation, checking
+// that each speaker is a participant and that acts are ordered as
+// IsChronological requires. Invalid acts are skipped, so each act's order is
+// checked against the last valid act before it. The context is checked before
+// each act; once it is done, its error is added and validation stops.
+func validateConversationActs(ctx context.Context, c Conversation) []error {
+	participants := make(map[string]struct{}, len(c.Participants))
+	for _, participant := range c.Participants {
+		participants[participant.ID] = struct{}{}
+	}
+
+	var errs []error
+	var previous ConversationAct
+	for i, act := range c.Acts {
+		if err := ctx.Err(); err != nil {
+			return append(errs, fmt.Errorf("validation stopped at act %d of %d: %w", i, len(c.Acts), err))
+		}
+		if err := ValidateAct(act); err != nil {
+			errs = append(errs, fmt.Errorf("act %d: %w", i, err))
+			continue
+		}
+		baseAct := act.GetAct()
+		if _, ok := participants[baseAct.Speaker]; !ok {
+			errs = append(errs, fmt.Errorf("act %d (%s): speaker %s is not a conversation participant", i, baseAct.ID, baseAct.Speaker))
+		}
+		if previous != nil && !actFollows(previous, act) {
+			errs = append(errs, fmt.Errorf("act %d (%s): timestamp precedes the previous act", i, baseAct.ID))
+		}
+		previous = act
+	}
+	return errs
+}
+
+// Validate checks the whole conversation: the ID is present and well formed,
+// there is at least one participant and no participant ID is repeated, every
+// act is valid, spoken by a participant, and in chronological order, and every
+// related_act_id resolves to an act in the conversation. All problems found
+// are returned; errors about individual acts name the act's index.
+func (c Conversation) Validate() []error {
+	return ValidateConversationContext(context.Background(), c)
+}
+
+// ValidateConversationContext checks a conversation like Conversation.Validate,
+// but checks ctx between acts. Once ctx is done, acts are no longer validated
+// and an error wrapping the context's error is returned along with the
+// problems found so far, so a deadline bounds the time spent on a very large
+// conversation.
+func ValidateConversationContext(ctx context.Context, c Conversation) []error {
+	var errs []error
+
+	if c.ID == "" {
+		errs = append(errs, fmt.Errorf("conversation ID is required"))
+	} else if !IsValidConversationID(c.ID) {
+		errs = append(errs, fmt.Errorf("invalid conversation ID format: %s", c.ID))
+	}
+
+	if len(c.Participants) == 0 {
+		errs = append(errs, fmt.Errorf("conversation must have at least one participant"))
+	}
+	seen := make(map[string]struct{}, len(c.Participants))
+	for i, participant := range c.Participants {
+		if participant.ID == "" {
+			errs = append(errs, fmt.Errorf("participant %d: ID is required", i))
+			continue
+		}
+		if _, ok := seen[participant.ID]; ok {
+			errs = append(errs, fmt.Errorf("participant %d: duplicate participant ID %s", i, participant.ID))
+			continue
+		}
+		seen[participant.ID] = struct{}{}
+	}
+
+	errs = append(errs, validateConversationActs(ctx, c)...)
+
+	for _, ref := range c.DanglingReferences() {
+		errs = append(errs, fmt.Errorf("related_act_id %s does not match any act in the conversation", ref))
+	}
+
+	return errs
+}
+
+// ValidateAgainstSchema marshals the conversation and validates the result
+// against ResolvedConversationSchema, the published conversation schema with
+// every act schema inlined. This enforces structural rules that exist only in
+// the schemas, such as required and unknown properties, enums, formats and
+// numeric bounds, and catches drift between the Go types and the schemas.
+// Unlike Validate, it stops at the first problem found.
+func (c Conversation) ValidateAgainstSchema() error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to decode conversation: %w", err)
+	}
+
+	if err := validateAgainstSchema(decoded, ResolvedConversationSchema()); err != nil {
+		return fmt.Errorf("conversation %s does not match schema: %w", c.ID, err)
+	}
+	return nil
+}
+
 // Validation functions
 
 // ValidateAct validates a ConversationAct against its schema requirements
@@ -250,30 +460,51 @@ func ValidateAct(act ConversationAct) error {
 	return act.Validate()
 }
 
+// ValidateActs validates each act in a batch with ValidateAct and returns an
+// IndexedError for every act that fails, in slice order. The result is empty
+// when all acts are valid.
+func ValidateActs(acts []ConversationAct) []IndexedError {
+	errs := []IndexedError{}
+	for i, act := range acts {
+		if err := ValidateAct(act); err != nil {
+			errs = append(errs, IndexedError{Index: i, Err: err})
+		}
+	}
+	return errs
+}
+
 // validateBaseAct validates the base Act properties
 func validateBaseAct(act Act) error {
 	if act.ID == "" {
-		return fmt.Errorf("act ID is required")
+		return ValidationError{Field: "id", Message: "act ID is required", Value: act.ID, Err: ErrMissingField}
 	}
 	
 	if !IsValidActID(act.ID) {
-		return fmt.Errorf("invalid act ID format: %s", act.ID)
+		return ValidationError{Field: "id", Message: "invalid act ID format", Value: act.ID}
 	}
 	
 	if act.Speaker == "" {
-		return fmt.Errorf("act speaker is required")
+		return ValidationError{Field: "speaker", Message: "act speaker is required", Value: act.Speaker, Err: ErrMissingField}
 	}
 	
 	if act.Type == "" {
-		return fmt.Errorf("act type is required")
+		return ValidationError{Field: "type", Message: "act type is required", Value: act.Type, Err: ErrMissingField}
 	}
 	
 	if !isValidActType(act.Type) {
-		return fmt.Errorf("invalid act type: %s", act.Type)
+		return InvalidActTypeError{ActType: string(act.Type)}
 	}
 	
 	if act.Confidence != nil && (*act.Confidence < 0.0 || *act.Confidence > 1.0) {
-		return fmt.Errorf("confidence must be between 0.0 and 1.0, got: %f", *act.Confidence)
+		return ValidationError{Field: "confidence", Message: "confidence must be between 0.0 and 1.0", Value: *act.Confidence}
+	}
+	
+	if act.Source != nil && !isValidSource(*act.Source) {
+		return ValidationError{Field: "source", Message: "invalid act source", Value: *act.Source}
+	}
+
+	if act.Signature != nil && act.Signature.Algorithm != SignatureAlgorithmEd25519 {
+		return ValidationError{Field: "signature", Message: "unsupported signature algorithm", Value: act.Signature.Algorithm}
 	}
 	
 	return nil
@@ -281,27 +512,89 @@ func validateBaseAct(act Act) error {
 
 // isValidActType checks if an ActType is valid
 func isValidActType(actType ActType) bool {
-	switch actType {
-	case ActTypeAsk, ActTypeFact, ActTypeConfirm, ActTypeCommit, ActTypeError:
+	return slices.Contains(actTypes, actType)
+}
+
+// customSources holds the Source values registered in addition to the built-ins
+var (
+	customSourcesMu sync.RWMutex
+	customSources   = make(map[Source]struct{})
+)
+
+// RegisterSource extends the set of Source values accepted by ValidateAct with
+// integrator-specific provenance such as "ivr" or "ocr". The five built-in
+// sources are always accepted. Registering an empty source is a no-op.
+//
+// Registration only affects Go validation: the embedded JSON schemas still
+// restrict source to the built-in enum, so ValidateJSON and
+// Conversation.ValidateAgainstSchema reject registered sources unless the
+// schemas are extended as well.
+func RegisterSource(source Source) {
+	if source == "" {
+		return
+	}
+	customSourcesMu.Lock()
+	defer customSourcesMu.Unlock()
+	customSources[source] = struct{}{}
+}
+
+// isValidSource checks if a Source is built-in or has been registered
+func isValidSource(source Source) bool {
+	if slices.Contains(builtinSources, source) {
 		return true
-	default:
-		return false
 	}
+	customSourcesMu.RLock()
+	defer customSourcesMu.RUnlock()
+	_, ok := customSources[source]
+	return ok
 }
 
 // Error types for better error handling
 
+// Sentinel errors matched by the error types below with errors.Is. Use
+// errors.As to recover the typed error and its details, such as the field name
+// of a ValidationError.
+var (
+	// ErrMissingField is matched by validation errors for a required field that is absent
+	ErrMissingField = errors.New("missing required field")
+	// ErrInvalidField is matched by validation errors for a field whose value is not allowed
+	ErrInvalidField = errors.New("invalid field value")
+	// ErrInvalidActType is matched by InvalidActTypeError
+	ErrInvalidActType = errors.New("invalid act type")
+	// ErrActNotFound is matched by ActNotFoundError
+	ErrActNotFound = errors.New("act not found")
+	// ErrSchemaNotRegistered is matched by SchemaNotRegisteredError
+	ErrSchemaNotRegistered = errors.New("business schema not registered")
+	// ErrInvalidSignature is matched by errors for an act signature that does
+	// not verify
+	ErrInvalidSignature = errors.New("invalid act signature")
+	// ErrFrameTooLarge is matched by errors for an act frame longer than the
+	// configured maximum
+	ErrFrameTooLarge = errors.New("act frame too large")
+)
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	Field   string
 	Message string
 	Value   interface{}
+	// Err is the sentinel the error matches, ErrMissingField or
+	// ErrInvalidField. When unset the error matches ErrInvalidField.
+	Err error
 }
 
 func (e ValidationError) Error() string {
 	return fmt.Sprintf("validation error for field '%s': %s (value: %v)", e.Field, e.Message, e.Value)
 }
 
+// Unwrap returns the sentinel the error matches
+func (e ValidationError) Unwrap() error {
+	if e.Err == nil {
+		return ErrInvalidField
+	}
+	return e.Err
+}
+
 // InvalidActTypeError represents an error when an invalid act type is encountered
 type InvalidActTypeError struct {
 	ActType string
@@ -311,6 +604,11 @@ func (e InvalidActTypeError) Error() string {
 	return fmt.Sprintf("invalid act type: %s", e.ActType)
 }
 
+// Unwrap returns ErrInvalidActType
+func (e InvalidActTypeError) Unwrap() error {
+	return ErrInvalidActType
+}
+
 // ActNotFoundError represents an error when an act is not found
 type ActNotFoundError struct {
 	ActID string
@@ -319,3 +617,49 @@ type ActNotFoundError struct {
 func (e ActNotFoundError) Error() string {
 	return fmt.Sprintf("act not found: %s", e.ActID)
 }
+
+// Unwrap returns ErrActNotFound
+func (e ActNotFoundError) Unwrap() error {
+	return ErrActNotFound
+}
+
+// SchemaNotRegisteredError represents an error when a business schema is not
+// in a SchemaRegistry
+type SchemaNotRegisteredError struct {
+	Schema string
+}
+
+func (e SchemaNotRegisteredError) Error() string {
+	return fmt.Sprintf("business schema not registered: %s", e.Schema)
+}
+
+// Unwrap returns ErrSchemaNotRegistered
+func (e SchemaNotRegisteredError) Unwrap() error {
+	return ErrSchemaNotRegistered
+}
+
+// IndexedError represents an error for the element at an index of a batch
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("act %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// CoercionError represents an error when a value cannot be coerced to an expected type
+type CoercionError struct {
+	Value        interface{}
+	ExpectedType ExpectedType
+	Reason       string
+}
+
+func (e CoercionError) Error() string {
+	return fmt.Sprintf("cannot coerce value %v to %s: %s", e.Value, e.ExpectedType, e.Reason)
+}
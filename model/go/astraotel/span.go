@@ -0,0 +1,101 @@
+// Package astraotel records the processing of ASTRA acts as OpenTelemetry
+// spans, keeping the OpenTelemetry dependency out of the core package.
+package astraotel
+
+import (
+	"context"
+	"fmt"
+
+	astra "github.com/pryszm/astra-model-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the tracer StartActSpan obtains
+// from the global TracerProvider
+const TracerName = "github.com/pryszm/astra-model-go/astraotel"
+
+// Span attribute keys set by StartActSpan and RecordError
+const (
+	ActIDKey            = attribute.Key("astra.act.id")
+	ActTypeKey          = attribute.Key("astra.act.type")
+	SpeakerKey          = attribute.Key("astra.act.speaker")
+	ConfidenceKey       = attribute.Key("astra.act.confidence")
+	EntityIDKey         = attribute.Key("astra.entity.id")
+	ErrorCodeKey        = attribute.Key("astra.error.code")
+	ErrorSeverityKey    = attribute.Key("astra.error.severity")
+	ErrorCategoryKey    = attribute.Key("astra.error.category")
+	ErrorRecoverableKey = attribute.Key("astra.error.recoverable")
+)
+
+// StartActSpan starts a span for processing an act, named after its type
+// (e.g. "astra.fact"), using the global TracerProvider. The span carries the
+// act's ID, type, and speaker, its confidence when set, and the ID of the
+// entity it refers to for facts, confirms, and commits. End the returned span
+// when processing is done.
+func StartActSpan(ctx context.Context, act astra.ConversationAct) (context.Context, trace.Span) {
+	base := act.GetAct()
+	attrs := []attribute.KeyValue{
+		ActIDKey.String(base.ID),
+		ActTypeKey.String(string(base.Type)),
+		SpeakerKey.String(base.Speaker),
+	}
+	if base.Confidence != nil {
+		attrs = append(attrs, ConfidenceKey.Float64(*base.Confidence))
+	}
+	if entityID, ok := actEntityID(act); ok {
+		attrs = append(attrs, EntityIDKey.String(entityID))
+	}
+
+	return otel.Tracer(TracerName).Start(ctx, "astra."+string(base.Type),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// RecordError records an ASTRA Error act on a span as an exception event with
+// the error's code, severity, category, and recoverability. Errors of info or
+// warning severity leave the span status unchanged; any other error, including
+// one without a severity, sets the span status to Error with the error's
+// message.
+func RecordError(span trace.Span, e astra.Error) {
+	attrs := []attribute.KeyValue{
+		ErrorCodeKey.String(e.Code),
+		ErrorRecoverableKey.Bool(e.Recoverable),
+	}
+	if e.Severity != nil {
+		attrs = append(attrs, ErrorSeverityKey.String(string(*e.Severity)))
+	}
+	if e.Category != nil {
+		attrs = append(attrs, ErrorCategoryKey.String(string(*e.Category)))
+	}
+	span.RecordError(fmt.Errorf("%s: %s", e.Code, e.Message), trace.WithAttributes(attrs...))
+
+	if e.Severity != nil && (*e.Severity == astra.ErrorSeverityInfo || *e.Severity == astra.ErrorSeverityWarning) {
+		return
+	}
+	span.SetStatus(codes.Error, e.Message)
+}
+
+// actEntityID returns the ID of the entity a fact, confirm, or commit refers to
+func actEntityID(act astra.ConversationAct) (string, bool) {
+	var ref astra.EntityRef
+	switch a := act.(type) {
+	case astra.Fact:
+		ref = a.Entity
+	case astra.Confirm:
+		ref = a.Entity
+	case astra.Commit:
+		ref = a.Entity
+	default:
+		return "", false
+	}
+
+	entityID, err := astra.GetEntityID(ref)
+	if err != nil {
+		return "", false
+	}
+	return entityID, true
+}
@@ -0,0 +1,109 @@
+package astraotel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	astra "github.com/pryszm/astra-model-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestStartActSpan(t *testing.T) {
+	recorder := newRecorder(t)
+
+	fact := astra.NewFact("customer_456", astra.NewEntity("order_789", "order"), "email", "user@example.com")
+	confidence := 0.9
+	fact.Confidence = &confidence
+
+	_, span := StartActSpan(context.Background(), fact)
+	span.End()
+	_, span = StartActSpan(context.Background(), astra.NewAsk("agent_123", "email", "What's your email?"))
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "astra.fact", spans[0].Name())
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		ActIDKey.String(fact.ID),
+		ActTypeKey.String("fact"),
+		SpeakerKey.String("customer_456"),
+		ConfidenceKey.Float64(0.9),
+		EntityIDKey.String("order_789"),
+	}, spans[0].Attributes())
+
+	assert.Equal(t, "astra.ask", spans[1].Name())
+	for _, attr := range spans[1].Attributes() {
+		assert.NotEqual(t, EntityIDKey, attr.Key, "asks do not refer to an entity")
+		assert.NotEqual(t, ConfidenceKey, attr.Key, "unset confidence is not recorded")
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	recorder := newRecorder(t)
+
+	failure := astra.NewError("system", "payment_declined", "Card was declined", false, astra.WithSeverity(astra.ErrorSeverityCritical))
+	_, span := StartActSpan(context.Background(), failure)
+	RecordError(span, failure)
+	span.End()
+
+	warning := astra.NewError("system", "slow_response", "Backend was slow", true, astra.WithSeverity(astra.ErrorSeverityWarning))
+	_, span = StartActSpan(context.Background(), warning)
+	RecordError(span, warning)
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "Card was declined", spans[0].Status().Description)
+	require.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, "exception", spans[0].Events()[0].Name)
+	assert.Contains(t, spans[0].Events()[0].Attributes, ErrorCodeKey.String("payment_declined"))
+
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	require.Len(t, spans[1].Events(), 1)
+	assert.Contains(t, spans[1].Events()[0].Attributes, ErrorRecoverableKey.Bool(true))
+}
+
+// Example traces each act of a conversation as it is processed, recording
+// Error acts on their spans
+func Example() {
+	conversation := astra.NewConversation([]astra.Participant{
+		astra.NewParticipant("agent_123", astra.ParticipantTypeAI),
+		astra.NewParticipant("customer_456", astra.ParticipantTypeHuman),
+	})
+	conversation.AddAct(astra.NewAsk("agent_123", "email", "What's your email?"))
+	conversation.AddAct(astra.NewFact("customer_456", "order_789", "email", "user@example.com"))
+	conversation.AddAct(astra.NewError("system", "crm_unavailable", "CRM did not respond", true))
+
+	ctx := context.Background()
+	for _, act := range conversation.Acts {
+		_, span := StartActSpan(ctx, act)
+		if errorAct, ok := act.(astra.Error); ok {
+			RecordError(span, errorAct)
+		}
+		// ... process the act with the span's context ...
+		span.End()
+
+		fmt.Println(act.GetAct().Type)
+	}
+	// Output:
+	// ask
+	// fact
+	// error
+}
@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: act.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Source that generated this act
+type Source int32
+
+const (
+	Source_SOURCE_UNSPECIFIED        Source = 0
+	Source_SOURCE_HUMAN              Source = 1
+	Source_SOURCE_SPEECH_RECOGNITION Source = 2
+	Source_SOURCE_TEXT_ANALYSIS      Source = 3
+	Source_SOURCE_SYSTEM             Source = 4
+	Source_SOURCE_AI                 Source = 5
+)
+
+// Enum value maps for Source.
+var (
+	Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_HUMAN",
+		2: "SOURCE_SPEECH_RECOGNITION",
+		3: "SOURCE_TEXT_ANALYSIS",
+		4: "SOURCE_SYSTEM",
+		5: "SOURCE_AI",
+	}
+	Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED":        0,
+		"SOURCE_HUMAN":              1,
+		"SOURCE_SPEECH_RECOGNITION": 2,
+		"SOURCE_TEXT_ANALYSIS":      3,
+		"SOURCE_SYSTEM":             4,
+		"SOURCE_AI":                 5,
+	}
+)
+
+func (x Source) Enum() *Source {
+	p := new(Source)
+	*p = x
+	return p
+}
+
+func (x Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_act_proto_enumTypes[0].Descriptor()
+}
+
+func (Source) Type() protoreflect.EnumType {
+	return &file_act_proto_enumTypes[0]
+}
+
+func (x Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Source.Descriptor instead.
+func (Source) EnumDescriptor() ([]byte, []int) {
+	return file_act_proto_rawDescGZIP(), []int{0}
+}
+
+// Type of conversational act
+type ActType int32
+
+const (
+	ActType_ACT_TYPE_UNSPECIFIED ActType = 0
+	ActType_ACT_TYPE_ASK         ActType = 1
+	ActType_ACT_TYPE_FACT        ActType = 2
+	ActType_ACT_TYPE_CONFIRM     ActType = 3
+	ActType_ACT_TYPE_COMMIT      ActType = 4
+	ActType_ACT_TYPE_ERROR       ActType = 5
+)
+
+// Enum value maps for ActType.
+var (
+	ActType_name = map[int32]string{
+		0: "ACT_TYPE_UNSPECIFIED",
+		1: "ACT_TYPE_ASK",
+		2: "ACT_TYPE_FACT",
+		3: "ACT_TYPE_CONFIRM",
+		4: "ACT_TYPE_COMMIT",
+		5: "ACT_TYPE_ERROR",
+	}
+	ActType_value = map[string]int32{
+		"ACT_TYPE_UNSPECIFIED": 0,
+		"ACT_TYPE_ASK":         1,
+		"ACT_TYPE_FACT":        2,
+		"ACT_TYPE_CONFIRM":     3,
+		"ACT_TYPE_COMMIT":      4,
+		"ACT_TYPE_ERROR":       5,
+	}
+)
+
+func (x ActType) Enum() *ActType {
+	p := new(ActType)
+	*p = x
+	return p
+}
+
+func (x ActType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActType) Descriptor() protoreflect.EnumDescriptor {
+	return file_act_proto_enumTypes[1].Descriptor()
+}
+
+func (ActType) Type() protoreflect.EnumType {
+	return &file_act_proto_enumTypes[1]
+}
+
+func (x ActType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActType.Descriptor instead.
+func (ActType) EnumDescriptor() ([]byte, []int) {
+	return file_act_proto_rawDescGZIP(), []int{1}
+}
+
+// Additional metadata for acts
+type ActMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Communication channel (voice, text, email, etc.)
+	Channel *string `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	// Language code (ISO 639-1, optional region)
+	Language *string `protobuf:"bytes,2,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	// Original utterance that generated this act
+	OriginalText *string `protobuf:"bytes,3,opt,name=original_text,json=originalText,proto3,oneof" json:"original_text,omitempty"`
+	// Time taken to process this act in milliseconds
+	ProcessingTimeMs *float64 `protobuf:"fixed64,4,opt,name=processing_time_ms,json=processingTimeMs,proto3,oneof" json:"processing_time_ms,omitempty"`
+	// Additional context-specific metadata
+	AdditionalProperties *structpb.Struct `protobuf:"bytes,5,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *ActMetadata) Reset() {
+	*x = ActMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_act_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActMetadata) ProtoMessage() {}
+
+func (x *ActMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_act_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActMetadata.ProtoReflect.Descriptor instead.
+func (*ActMetadata) Descriptor() ([]byte, []int) {
+	return file_act_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActMetadata) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *ActMetadata) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *ActMetadata) GetOriginalText() string {
+	if x != nil && x.OriginalText != nil {
+		return *x.OriginalText
+	}
+	return ""
+}
+
+func (x *ActMetadata) GetProcessingTimeMs() float64 {
+	if x != nil && x.ProcessingTimeMs != nil {
+		return *x.ProcessingTimeMs
+	}
+	return 0
+}
+
+func (x *ActMetadata) GetAdditionalProperties() *structpb.Struct {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+// Base type for all conversational actions in ASTRA
+type Act struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier for this act within the conversation
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Timestamp when the act occurred
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Identifier of the conversation participant who performed this act
+	Speaker string `protobuf:"bytes,3,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	// Type of conversational act being performed
+	Type ActType `protobuf:"varint,4,opt,name=type,proto3,enum=astra.v1.ActType" json:"type,omitempty"`
+	// Confidence score for automated act extraction (0.0 to 1.0)
+	Confidence *float64 `protobuf:"fixed64,5,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`
+	// Source that generated this act
+	Source *Source `protobuf:"varint,6,opt,name=source,proto3,enum=astra.v1.Source,oneof" json:"source,omitempty"`
+	// Additional context-specific metadata
+	Metadata *ActMetadata `protobuf:"bytes,7,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+}
+
+func (x *Act) Reset() {
+	*x = Act{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_act_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Act) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Act) ProtoMessage() {}
+
+func (x *Act) ProtoReflect() protoreflect.Message {
+	mi := &file_act_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Act.ProtoReflect.Descriptor instead.
+func (*Act) Descriptor() ([]byte, []int) {
+	return file_act_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Act) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Act) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Act) GetSpeaker() string {
+	if x != nil {
+		return x.Speaker
+	}
+	return ""
+}
+
+func (x *Act) GetType() ActType {
+	if x != nil {
+		return x.Type
+	}
+	return ActType_ACT_TYPE_UNSPECIFIED
+}
+
+func (x *Act) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *Act) GetSource() Source {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return Source_SOURCE_UNSPECIFIED
+}
+
+func (x *Act) GetMetadata() *ActMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_act_proto protoreflect.FileDescriptor
+
+var file_act_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x10, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x4c, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x22, 0xc3, 0x02, 0x0a, 0x03, 0x41, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x01, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x48, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x8d, 0x01, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x47, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59,
+	0x53, 0x49, 0x53, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x41, 0x49, 0x10, 0x05, 0x2a, 0x87, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x43, 0x54,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x04, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x05, 0x42, 0x5b, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x63, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50,
+	0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_act_proto_rawDescOnce sync.Once
+	file_act_proto_rawDescData = file_act_proto_rawDesc
+)
+
+func file_act_proto_rawDescGZIP() []byte {
+	file_act_proto_rawDescOnce.Do(func() {
+		file_act_proto_rawDescData = protoimpl.X.CompressGZIP(file_act_proto_rawDescData)
+	})
+	return file_act_proto_rawDescData
+}
+
+var file_act_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_act_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_act_proto_goTypes = []any{
+	(Source)(0),                   // 0: astra.v1.Source
+	(ActType)(0),                  // 1: astra.v1.ActType
+	(*ActMetadata)(nil),           // 2: astra.v1.ActMetadata
+	(*Act)(nil),                   // 3: astra.v1.Act
+	(*structpb.Struct)(nil),       // 4: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_act_proto_depIdxs = []int32{
+	4, // 0: astra.v1.ActMetadata.additional_properties:type_name -> google.protobuf.Struct
+	5, // 1: astra.v1.Act.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 2: astra.v1.Act.type:type_name -> astra.v1.ActType
+	0, // 3: astra.v1.Act.source:type_name -> astra.v1.Source
+	2, // 4: astra.v1.Act.metadata:type_name -> astra.v1.ActMetadata
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_act_proto_init() }
+func file_act_proto_init() {
+	if File_act_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_act_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ActMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_act_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Act); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_act_proto_msgTypes[0].OneofWrappers = []any{}
+	file_act_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_act_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_act_proto_goTypes,
+		DependencyIndexes: file_act_proto_depIdxs,
+		EnumInfos:         file_act_proto_enumTypes,
+		MessageInfos:      file_act_proto_msgTypes,
+	}.Build()
+	File_act_proto = out.File
+	file_act_proto_rawDesc = nil
+	file_act_proto_goTypes = nil
+	file_act_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: ask.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Expected data type of the response
+type ExpectedType int32
+
+const (
+	ExpectedType_EXPECTED_TYPE_UNSPECIFIED ExpectedType = 0
+	ExpectedType_EXPECTED_TYPE_STRING      ExpectedType = 1
+	ExpectedType_EXPECTED_TYPE_NUMBER      ExpectedType = 2
+	ExpectedType_EXPECTED_TYPE_BOOLEAN     ExpectedType = 3
+	ExpectedType_EXPECTED_TYPE_OBJECT      ExpectedType = 4
+	ExpectedType_EXPECTED_TYPE_ARRAY       ExpectedType = 5
+	ExpectedType_EXPECTED_TYPE_DATE        ExpectedType = 6
+	ExpectedType_EXPECTED_TYPE_EMAIL       ExpectedType = 7
+	ExpectedType_EXPECTED_TYPE_PHONE       ExpectedType = 8
+	ExpectedType_EXPECTED_TYPE_ADDRESS     ExpectedType = 9
+)
+
+// Enum value maps for ExpectedType.
+var (
+	ExpectedType_name = map[int32]string{
+		0: "EXPECTED_TYPE_UNSPECIFIED",
+		1: "EXPECTED_TYPE_STRING",
+		2: "EXPECTED_TYPE_NUMBER",
+		3: "EXPECTED_TYPE_BOOLEAN",
+		4: "EXPECTED_TYPE_OBJECT",
+		5: "EXPECTED_TYPE_ARRAY",
+		6: "EXPECTED_TYPE_DATE",
+		7: "EXPECTED_TYPE_EMAIL",
+		8: "EXPECTED_TYPE_PHONE",
+		9: "EXPECTED_TYPE_ADDRESS",
+	}
+	ExpectedType_value = map[string]int32{
+		"EXPECTED_TYPE_UNSPECIFIED": 0,
+		"EXPECTED_TYPE_STRING":      1,
+		"EXPECTED_TYPE_NUMBER":      2,
+		"EXPECTED_TYPE_BOOLEAN":     3,
+		"EXPECTED_TYPE_OBJECT":      4,
+		"EXPECTED_TYPE_ARRAY":       5,
+		"EXPECTED_TYPE_DATE":        6,
+		"EXPECTED_TYPE_EMAIL":       7,
+		"EXPECTED_TYPE_PHONE":       8,
+		"EXPECTED_TYPE_ADDRESS":     9,
+	}
+)
+
+func (x ExpectedType) Enum() *ExpectedType {
+	p := new(ExpectedType)
+	*p = x
+	return p
+}
+
+func (x ExpectedType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpectedType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ask_proto_enumTypes[0].Descriptor()
+}
+
+func (ExpectedType) Type() protoreflect.EnumType {
+	return &file_ask_proto_enumTypes[0]
+}
+
+func (x ExpectedType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpectedType.Descriptor instead.
+func (ExpectedType) EnumDescriptor() ([]byte, []int) {
+	return file_ask_proto_rawDescGZIP(), []int{0}
+}
+
+// Act that requests missing information required to complete a business process
+type Ask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base act properties
+	Act *Act `protobuf:"bytes,1,opt,name=act,proto3" json:"act,omitempty"`
+	// Field or information being requested
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// Question or request presented to obtain the information
+	Prompt string `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// Validation constraints for the requested information
+	Constraints []*Constraint `protobuf:"bytes,4,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	// Whether this information is required to proceed
+	Required *bool `protobuf:"varint,5,opt,name=required,proto3,oneof" json:"required,omitempty"` // defaults to true
+	// Expected data type of the response
+	ExpectedType *ExpectedType `protobuf:"varint,6,opt,name=expected_type,json=expectedType,proto3,enum=astra.v1.ExpectedType,oneof" json:"expected_type,omitempty"`
+	// Number of times this question has been asked
+	RetryCount *int32 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count,omitempty"` // defaults to 0
+	// Maximum number of retry attempts before escalation
+	MaxRetries *int32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"` // defaults to 3
+}
+
+func (x *Ask) Reset() {
+	*x = Ask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ask_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ask) ProtoMessage() {}
+
+func (x *Ask) ProtoReflect() protoreflect.Message {
+	mi := &file_ask_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ask.ProtoReflect.Descriptor instead.
+func (*Ask) Descriptor() ([]byte, []int) {
+	return file_ask_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ask) GetAct() *Act {
+	if x != nil {
+		return x.Act
+	}
+	return nil
+}
+
+func (x *Ask) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Ask) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *Ask) GetConstraints() []*Constraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *Ask) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *Ask) GetExpectedType() ExpectedType {
+	if x != nil && x.ExpectedType != nil {
+		return *x.ExpectedType
+	}
+	return ExpectedType_EXPECTED_TYPE_UNSPECIFIED
+}
+
+func (x *Ask) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
+	}
+	return 0
+}
+
+func (x *Ask) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+var File_ask_proto protoreflect.FileDescriptor
+
+var file_ask_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xfa, 0x02, 0x0a, 0x03, 0x41, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2a,
+	0x94, 0x02, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10,
+	0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c,
+	0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x45,
+	0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x09, 0x42, 0x5b, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61,
+	0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61,
+	0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ask_proto_rawDescOnce sync.Once
+	file_ask_proto_rawDescData = file_ask_proto_rawDesc
+)
+
+func file_ask_proto_rawDescGZIP() []byte {
+	file_ask_proto_rawDescOnce.Do(func() {
+		file_ask_proto_rawDescData = protoimpl.X.CompressGZIP(file_ask_proto_rawDescData)
+	})
+	return file_ask_proto_rawDescData
+}
+
+var file_ask_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ask_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ask_proto_goTypes = []any{
+	(ExpectedType)(0),  // 0: astra.v1.ExpectedType
+	(*Ask)(nil),        // 1: astra.v1.Ask
+	(*Act)(nil),        // 2: astra.v1.Act
+	(*Constraint)(nil), // 3: astra.v1.Constraint
+}
+var file_ask_proto_depIdxs = []int32{
+	2, // 0: astra.v1.Ask.act:type_name -> astra.v1.Act
+	3, // 1: astra.v1.Ask.constraints:type_name -> astra.v1.Constraint
+	0, // 2: astra.v1.Ask.expected_type:type_name -> astra.v1.ExpectedType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ask_proto_init() }
+func file_ask_proto_init() {
+	if File_ask_proto != nil {
+		return
+	}
+	file_act_proto_init()
+	file_constraint_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ask_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Ask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ask_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ask_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ask_proto_goTypes,
+		DependencyIndexes: file_ask_proto_depIdxs,
+		EnumInfos:         file_ask_proto_enumTypes,
+		MessageInfos:      file_ask_proto_msgTypes,
+	}.Build()
+	File_ask_proto = out.File
+	file_ask_proto_rawDesc = nil
+	file_ask_proto_goTypes = nil
+	file_ask_proto_depIdxs = nil
+}
@@ -0,0 +1,551 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: commit.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Action being performed in the target system
+type CommitAction int32
+
+const (
+	CommitAction_COMMIT_ACTION_UNSPECIFIED CommitAction = 0
+	CommitAction_COMMIT_ACTION_CREATE      CommitAction = 1
+	CommitAction_COMMIT_ACTION_UPDATE      CommitAction = 2
+	CommitAction_COMMIT_ACTION_DELETE      CommitAction = 3
+	CommitAction_COMMIT_ACTION_EXECUTE     CommitAction = 4
+	CommitAction_COMMIT_ACTION_CANCEL      CommitAction = 5
+	CommitAction_COMMIT_ACTION_PAUSE       CommitAction = 6
+	CommitAction_COMMIT_ACTION_RESUME      CommitAction = 7
+)
+
+// Enum value maps for CommitAction.
+var (
+	CommitAction_name = map[int32]string{
+		0: "COMMIT_ACTION_UNSPECIFIED",
+		1: "COMMIT_ACTION_CREATE",
+		2: "COMMIT_ACTION_UPDATE",
+		3: "COMMIT_ACTION_DELETE",
+		4: "COMMIT_ACTION_EXECUTE",
+		5: "COMMIT_ACTION_CANCEL",
+		6: "COMMIT_ACTION_PAUSE",
+		7: "COMMIT_ACTION_RESUME",
+	}
+	CommitAction_value = map[string]int32{
+		"COMMIT_ACTION_UNSPECIFIED": 0,
+		"COMMIT_ACTION_CREATE":      1,
+		"COMMIT_ACTION_UPDATE":      2,
+		"COMMIT_ACTION_DELETE":      3,
+		"COMMIT_ACTION_EXECUTE":     4,
+		"COMMIT_ACTION_CANCEL":      5,
+		"COMMIT_ACTION_PAUSE":       6,
+		"COMMIT_ACTION_RESUME":      7,
+	}
+)
+
+func (x CommitAction) Enum() *CommitAction {
+	p := new(CommitAction)
+	*p = x
+	return p
+}
+
+func (x CommitAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_commit_proto_enumTypes[0].Descriptor()
+}
+
+func (CommitAction) Type() protoreflect.EnumType {
+	return &file_commit_proto_enumTypes[0]
+}
+
+func (x CommitAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitAction.Descriptor instead.
+func (CommitAction) EnumDescriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{0}
+}
+
+// Status of the commit operation
+type CommitStatus int32
+
+const (
+	CommitStatus_COMMIT_STATUS_UNSPECIFIED CommitStatus = 0
+	CommitStatus_COMMIT_STATUS_PENDING     CommitStatus = 1
+	CommitStatus_COMMIT_STATUS_IN_PROGRESS CommitStatus = 2
+	CommitStatus_COMMIT_STATUS_SUCCESS     CommitStatus = 3
+	CommitStatus_COMMIT_STATUS_FAILED      CommitStatus = 4
+	CommitStatus_COMMIT_STATUS_RETRYING    CommitStatus = 5
+	CommitStatus_COMMIT_STATUS_CANCELLED   CommitStatus = 6
+)
+
+// Enum value maps for CommitStatus.
+var (
+	CommitStatus_name = map[int32]string{
+		0: "COMMIT_STATUS_UNSPECIFIED",
+		1: "COMMIT_STATUS_PENDING",
+		2: "COMMIT_STATUS_IN_PROGRESS",
+		3: "COMMIT_STATUS_SUCCESS",
+		4: "COMMIT_STATUS_FAILED",
+		5: "COMMIT_STATUS_RETRYING",
+		6: "COMMIT_STATUS_CANCELLED",
+	}
+	CommitStatus_value = map[string]int32{
+		"COMMIT_STATUS_UNSPECIFIED": 0,
+		"COMMIT_STATUS_PENDING":     1,
+		"COMMIT_STATUS_IN_PROGRESS": 2,
+		"COMMIT_STATUS_SUCCESS":     3,
+		"COMMIT_STATUS_FAILED":      4,
+		"COMMIT_STATUS_RETRYING":    5,
+		"COMMIT_STATUS_CANCELLED":   6,
+	}
+)
+
+func (x CommitStatus) Enum() *CommitStatus {
+	p := new(CommitStatus)
+	*p = x
+	return p
+}
+
+func (x CommitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_commit_proto_enumTypes[1].Descriptor()
+}
+
+func (CommitStatus) Type() protoreflect.EnumType {
+	return &file_commit_proto_enumTypes[1]
+}
+
+func (x CommitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitStatus.Descriptor instead.
+func (CommitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{1}
+}
+
+// Error information for failed commits
+type CommitError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Error code from the target system
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable error message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Additional error context
+	Details *structpb.Struct `protobuf:"bytes,3,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	// Whether the error can be recovered from
+	Recoverable bool `protobuf:"varint,4,opt,name=recoverable,proto3" json:"recoverable,omitempty"`
+}
+
+func (x *CommitError) Reset() {
+	*x = CommitError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitError) ProtoMessage() {}
+
+func (x *CommitError) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitError.ProtoReflect.Descriptor instead.
+func (*CommitError) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommitError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CommitError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitError) GetDetails() *structpb.Struct {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *CommitError) GetRecoverable() bool {
+	if x != nil {
+		return x.Recoverable
+	}
+	return false
+}
+
+// Act that executes business processes and triggers system integrations
+type Commit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base act properties
+	Act *Act `protobuf:"bytes,1,opt,name=act,proto3" json:"act,omitempty"`
+	// Business entity being committed to external systems
+	Entity *EntityRef `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Action being performed in the target system
+	Action CommitAction `protobuf:"varint,3,opt,name=action,proto3,enum=astra.v1.CommitAction" json:"action,omitempty"`
+	// Target system identifier (CRM, order_management, etc.)
+	System *string `protobuf:"bytes,4,opt,name=system,proto3,oneof" json:"system,omitempty"`
+	// External system transaction or record identifier
+	TransactionId *string `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// Status of the commit operation
+	Status CommitStatus `protobuf:"varint,6,opt,name=status,proto3,enum=astra.v1.CommitStatus" json:"status,omitempty"` // defaults to PENDING
+	// Error information if commit failed
+	Error *CommitError `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Number of retry attempts made
+	RetryCount *int32 `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count,omitempty"` // defaults to 0
+	// Maximum number of retry attempts
+	MaxRetries *int32 `protobuf:"varint,9,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"` // defaults to 3
+	// Key to ensure idempotent operations
+	IdempotencyKey *string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	// Information needed to rollback this commit if necessary
+	RollbackInfo *structpb.Struct `protobuf:"bytes,11,opt,name=rollback_info,json=rollbackInfo,proto3,oneof" json:"rollback_info,omitempty"`
+}
+
+func (x *Commit) Reset() {
+	*x = Commit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commit) ProtoMessage() {}
+
+func (x *Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_commit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commit.ProtoReflect.Descriptor instead.
+func (*Commit) Descriptor() ([]byte, []int) {
+	return file_commit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Commit) GetAct() *Act {
+	if x != nil {
+		return x.Act
+	}
+	return nil
+}
+
+func (x *Commit) GetEntity() *EntityRef {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *Commit) GetAction() CommitAction {
+	if x != nil {
+		return x.Action
+	}
+	return CommitAction_COMMIT_ACTION_UNSPECIFIED
+}
+
+func (x *Commit) GetSystem() string {
+	if x != nil && x.System != nil {
+		return *x.System
+	}
+	return ""
+}
+
+func (x *Commit) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *Commit) GetStatus() CommitStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommitStatus_COMMIT_STATUS_UNSPECIFIED
+}
+
+func (x *Commit) GetError() *CommitError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Commit) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
+	}
+	return 0
+}
+
+func (x *Commit) GetMaxRetries() int32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+func (x *Commit) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Commit) GetRollbackInfo() *structpb.Struct {
+	if x != nil {
+		return x.RollbackInfo
+	}
+	return nil
+}
+
+var File_commit_proto protoreflect.FileDescriptor
+
+var file_commit_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa1, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x22, 0xdc, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x03, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x66, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x02, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x48, 0x06, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x2a, 0xe3, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x06, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x07, 0x2a, 0xd5, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x49, 0x4e,
+	0x47, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06,
+	0x42, 0x5e, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02,
+	0x0f, 0x50, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_commit_proto_rawDescOnce sync.Once
+	file_commit_proto_rawDescData = file_commit_proto_rawDesc
+)
+
+func file_commit_proto_rawDescGZIP() []byte {
+	file_commit_proto_rawDescOnce.Do(func() {
+		file_commit_proto_rawDescData = protoimpl.X.CompressGZIP(file_commit_proto_rawDescData)
+	})
+	return file_commit_proto_rawDescData
+}
+
+var file_commit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_commit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_commit_proto_goTypes = []any{
+	(CommitAction)(0),       // 0: astra.v1.CommitAction
+	(CommitStatus)(0),       // 1: astra.v1.CommitStatus
+	(*CommitError)(nil),     // 2: astra.v1.CommitError
+	(*Commit)(nil),          // 3: astra.v1.Commit
+	(*structpb.Struct)(nil), // 4: google.protobuf.Struct
+	(*Act)(nil),             // 5: astra.v1.Act
+	(*EntityRef)(nil),       // 6: astra.v1.EntityRef
+}
+var file_commit_proto_depIdxs = []int32{
+	4, // 0: astra.v1.CommitError.details:type_name -> google.protobuf.Struct
+	5, // 1: astra.v1.Commit.act:type_name -> astra.v1.Act
+	6, // 2: astra.v1.Commit.entity:type_name -> astra.v1.EntityRef
+	0, // 3: astra.v1.Commit.action:type_name -> astra.v1.CommitAction
+	1, // 4: astra.v1.Commit.status:type_name -> astra.v1.CommitStatus
+	2, // 5: astra.v1.Commit.error:type_name -> astra.v1.CommitError
+	4, // 6: astra.v1.Commit.rollback_info:type_name -> google.protobuf.Struct
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_commit_proto_init() }
+func file_commit_proto_init() {
+	if File_commit_proto != nil {
+		return
+	}
+	file_act_proto_init()
+	file_entity_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_commit_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CommitError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commit_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_commit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_commit_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_commit_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_commit_proto_goTypes,
+		DependencyIndexes: file_commit_proto_depIdxs,
+		EnumInfos:         file_commit_proto_enumTypes,
+		MessageInfos:      file_commit_proto_msgTypes,
+	}.Build()
+	File_commit_proto = out.File
+	file_commit_proto_rawDesc = nil
+	file_commit_proto_goTypes = nil
+	file_commit_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: confirm.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How the confirmation was obtained
+type ConfirmationMethod int32
+
+const (
+	ConfirmationMethod_CONFIRMATION_METHOD_UNSPECIFIED ConfirmationMethod = 0
+	ConfirmationMethod_CONFIRMATION_METHOD_VERBAL      ConfirmationMethod = 1
+	ConfirmationMethod_CONFIRMATION_METHOD_EXPLICIT    ConfirmationMethod = 2
+	ConfirmationMethod_CONFIRMATION_METHOD_IMPLICIT    ConfirmationMethod = 3
+	ConfirmationMethod_CONFIRMATION_METHOD_TIMEOUT     ConfirmationMethod = 4
+	ConfirmationMethod_CONFIRMATION_METHOD_SYSTEM      ConfirmationMethod = 5
+)
+
+// Enum value maps for ConfirmationMethod.
+var (
+	ConfirmationMethod_name = map[int32]string{
+		0: "CONFIRMATION_METHOD_UNSPECIFIED",
+		1: "CONFIRMATION_METHOD_VERBAL",
+		2: "CONFIRMATION_METHOD_EXPLICIT",
+		3: "CONFIRMATION_METHOD_IMPLICIT",
+		4: "CONFIRMATION_METHOD_TIMEOUT",
+		5: "CONFIRMATION_METHOD_SYSTEM",
+	}
+	ConfirmationMethod_value = map[string]int32{
+		"CONFIRMATION_METHOD_UNSPECIFIED": 0,
+		"CONFIRMATION_METHOD_VERBAL":      1,
+		"CONFIRMATION_METHOD_EXPLICIT":    2,
+		"CONFIRMATION_METHOD_IMPLICIT":    3,
+		"CONFIRMATION_METHOD_TIMEOUT":     4,
+		"CONFIRMATION_METHOD_SYSTEM":      5,
+	}
+)
+
+func (x ConfirmationMethod) Enum() *ConfirmationMethod {
+	p := new(ConfirmationMethod)
+	*p = x
+	return p
+}
+
+func (x ConfirmationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfirmationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_confirm_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfirmationMethod) Type() protoreflect.EnumType {
+	return &file_confirm_proto_enumTypes[0]
+}
+
+func (x ConfirmationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfirmationMethod.Descriptor instead.
+func (ConfirmationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_confirm_proto_rawDescGZIP(), []int{0}
+}
+
+// Act that verifies understanding of information before commitment
+type Confirm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base act properties
+	Act *Act `protobuf:"bytes,1,opt,name=act,proto3" json:"act,omitempty"`
+	// Business entity being confirmed
+	Entity *EntityRef `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Human-readable summary of what is being confirmed
+	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Whether confirmation is still pending
+	Awaiting *bool `protobuf:"varint,4,opt,name=awaiting,proto3,oneof" json:"awaiting,omitempty"` // defaults to true
+	// Whether the confirmation was accepted (true) or rejected (false)
+	Confirmed *bool `protobuf:"varint,5,opt,name=confirmed,proto3,oneof" json:"confirmed,omitempty"`
+	// How the confirmation was obtained
+	ConfirmationMethod *ConfirmationMethod `protobuf:"varint,6,opt,name=confirmation_method,json=confirmationMethod,proto3,enum=astra.v1.ConfirmationMethod,oneof" json:"confirmation_method,omitempty"`
+	// Specific fields or aspects being confirmed
+	FieldsConfirmed []string `protobuf:"bytes,7,rep,name=fields_confirmed,json=fieldsConfirmed,proto3" json:"fields_confirmed,omitempty"`
+	// Reason provided if confirmation was rejected
+	RejectionReason *string `protobuf:"bytes,8,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	// Timeout for awaiting confirmation in milliseconds
+	TimeoutMs *int64 `protobuf:"varint,9,opt,name=timeout_ms,json=timeoutMs,proto3,oneof" json:"timeout_ms,omitempty"`
+}
+
+func (x *Confirm) Reset() {
+	*x = Confirm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_confirm_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Confirm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Confirm) ProtoMessage() {}
+
+func (x *Confirm) ProtoReflect() protoreflect.Message {
+	mi := &file_confirm_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Confirm.ProtoReflect.Descriptor instead.
+func (*Confirm) Descriptor() ([]byte, []int) {
+	return file_confirm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Confirm) GetAct() *Act {
+	if x != nil {
+		return x.Act
+	}
+	return nil
+}
+
+func (x *Confirm) GetEntity() *EntityRef {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *Confirm) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Confirm) GetAwaiting() bool {
+	if x != nil && x.Awaiting != nil {
+		return *x.Awaiting
+	}
+	return false
+}
+
+func (x *Confirm) GetConfirmed() bool {
+	if x != nil && x.Confirmed != nil {
+		return *x.Confirmed
+	}
+	return false
+}
+
+func (x *Confirm) GetConfirmationMethod() ConfirmationMethod {
+	if x != nil && x.ConfirmationMethod != nil {
+		return *x.ConfirmationMethod
+	}
+	return ConfirmationMethod_CONFIRMATION_METHOD_UNSPECIFIED
+}
+
+func (x *Confirm) GetFieldsConfirmed() []string {
+	if x != nil {
+		return x.FieldsConfirmed
+	}
+	return nil
+}
+
+func (x *Confirm) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Confirm) GetTimeoutMs() int64 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
+var File_confirm_proto protoreflect.FileDescriptor
+
+var file_confirm_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xdf, 0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x1f,
+	0x0a, 0x03, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x66, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x61, 0x77, 0x61, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x48, 0x02, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x73, 0x2a, 0xde, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x49, 0x43,
+	0x49, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x10, 0x05, 0x42, 0x5f, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x79,
+	0x73, 0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_confirm_proto_rawDescOnce sync.Once
+	file_confirm_proto_rawDescData = file_confirm_proto_rawDesc
+)
+
+func file_confirm_proto_rawDescGZIP() []byte {
+	file_confirm_proto_rawDescOnce.Do(func() {
+		file_confirm_proto_rawDescData = protoimpl.X.CompressGZIP(file_confirm_proto_rawDescData)
+	})
+	return file_confirm_proto_rawDescData
+}
+
+var file_confirm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_confirm_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_confirm_proto_goTypes = []any{
+	(ConfirmationMethod)(0), // 0: astra.v1.ConfirmationMethod
+	(*Confirm)(nil),         // 1: astra.v1.Confirm
+	(*Act)(nil),             // 2: astra.v1.Act
+	(*EntityRef)(nil),       // 3: astra.v1.EntityRef
+}
+var file_confirm_proto_depIdxs = []int32{
+	2, // 0: astra.v1.Confirm.act:type_name -> astra.v1.Act
+	3, // 1: astra.v1.Confirm.entity:type_name -> astra.v1.EntityRef
+	0, // 2: astra.v1.Confirm.confirmation_method:type_name -> astra.v1.ConfirmationMethod
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_confirm_proto_init() }
+func file_confirm_proto_init() {
+	if File_confirm_proto != nil {
+		return
+	}
+	file_act_proto_init()
+	file_entity_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_confirm_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Confirm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_confirm_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_confirm_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_confirm_proto_goTypes,
+		DependencyIndexes: file_confirm_proto_depIdxs,
+		EnumInfos:         file_confirm_proto_enumTypes,
+		MessageInfos:      file_confirm_proto_msgTypes,
+	}.Build()
+	File_confirm_proto = out.File
+	file_confirm_proto_rawDesc = nil
+	file_confirm_proto_goTypes = nil
+	file_confirm_proto_depIdxs = nil
+}
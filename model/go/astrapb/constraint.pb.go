@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: constraint.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Type of constraint being applied
+type ConstraintType int32
+
+const (
+	ConstraintType_CONSTRAINT_TYPE_UNSPECIFIED ConstraintType = 0
+	ConstraintType_CONSTRAINT_TYPE_REQUIRED    ConstraintType = 1
+	ConstraintType_CONSTRAINT_TYPE_OPTIONAL    ConstraintType = 2
+	ConstraintType_CONSTRAINT_TYPE_MIN_LENGTH  ConstraintType = 3
+	ConstraintType_CONSTRAINT_TYPE_MAX_LENGTH  ConstraintType = 4
+	ConstraintType_CONSTRAINT_TYPE_PATTERN     ConstraintType = 5
+	ConstraintType_CONSTRAINT_TYPE_FORMAT      ConstraintType = 6
+	ConstraintType_CONSTRAINT_TYPE_RANGE       ConstraintType = 7
+	ConstraintType_CONSTRAINT_TYPE_ENUM        ConstraintType = 8
+	ConstraintType_CONSTRAINT_TYPE_CUSTOM      ConstraintType = 9
+)
+
+// Enum value maps for ConstraintType.
+var (
+	ConstraintType_name = map[int32]string{
+		0: "CONSTRAINT_TYPE_UNSPECIFIED",
+		1: "CONSTRAINT_TYPE_REQUIRED",
+		2: "CONSTRAINT_TYPE_OPTIONAL",
+		3: "CONSTRAINT_TYPE_MIN_LENGTH",
+		4: "CONSTRAINT_TYPE_MAX_LENGTH",
+		5: "CONSTRAINT_TYPE_PATTERN",
+		6: "CONSTRAINT_TYPE_FORMAT",
+		7: "CONSTRAINT_TYPE_RANGE",
+		8: "CONSTRAINT_TYPE_ENUM",
+		9: "CONSTRAINT_TYPE_CUSTOM",
+	}
+	ConstraintType_value = map[string]int32{
+		"CONSTRAINT_TYPE_UNSPECIFIED": 0,
+		"CONSTRAINT_TYPE_REQUIRED":    1,
+		"CONSTRAINT_TYPE_OPTIONAL":    2,
+		"CONSTRAINT_TYPE_MIN_LENGTH":  3,
+		"CONSTRAINT_TYPE_MAX_LENGTH":  4,
+		"CONSTRAINT_TYPE_PATTERN":     5,
+		"CONSTRAINT_TYPE_FORMAT":      6,
+		"CONSTRAINT_TYPE_RANGE":       7,
+		"CONSTRAINT_TYPE_ENUM":        8,
+		"CONSTRAINT_TYPE_CUSTOM":      9,
+	}
+)
+
+func (x ConstraintType) Enum() *ConstraintType {
+	p := new(ConstraintType)
+	*p = x
+	return p
+}
+
+func (x ConstraintType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConstraintType) Descriptor() protoreflect.EnumDescriptor {
+	return file_constraint_proto_enumTypes[0].Descriptor()
+}
+
+func (ConstraintType) Type() protoreflect.EnumType {
+	return &file_constraint_proto_enumTypes[0]
+}
+
+func (x ConstraintType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConstraintType.Descriptor instead.
+func (ConstraintType) EnumDescriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{0}
+}
+
+// Format validation types
+type FormatType int32
+
+const (
+	FormatType_FORMAT_TYPE_UNSPECIFIED FormatType = 0
+	FormatType_FORMAT_TYPE_EMAIL       FormatType = 1
+	FormatType_FORMAT_TYPE_PHONE       FormatType = 2
+	FormatType_FORMAT_TYPE_URL         FormatType = 3
+	FormatType_FORMAT_TYPE_DATE        FormatType = 4
+	FormatType_FORMAT_TYPE_TIME        FormatType = 5
+	FormatType_FORMAT_TYPE_DATETIME    FormatType = 6
+	FormatType_FORMAT_TYPE_UUID        FormatType = 7
+	FormatType_FORMAT_TYPE_IPV4        FormatType = 8
+	FormatType_FORMAT_TYPE_IPV6        FormatType = 9
+)
+
+// Enum value maps for FormatType.
+var (
+	FormatType_name = map[int32]string{
+		0: "FORMAT_TYPE_UNSPECIFIED",
+		1: "FORMAT_TYPE_EMAIL",
+		2: "FORMAT_TYPE_PHONE",
+		3: "FORMAT_TYPE_URL",
+		4: "FORMAT_TYPE_DATE",
+		5: "FORMAT_TYPE_TIME",
+		6: "FORMAT_TYPE_DATETIME",
+		7: "FORMAT_TYPE_UUID",
+		8: "FORMAT_TYPE_IPV4",
+		9: "FORMAT_TYPE_IPV6",
+	}
+	FormatType_value = map[string]int32{
+		"FORMAT_TYPE_UNSPECIFIED": 0,
+		"FORMAT_TYPE_EMAIL":       1,
+		"FORMAT_TYPE_PHONE":       2,
+		"FORMAT_TYPE_URL":         3,
+		"FORMAT_TYPE_DATE":        4,
+		"FORMAT_TYPE_TIME":        5,
+		"FORMAT_TYPE_DATETIME":    6,
+		"FORMAT_TYPE_UUID":        7,
+		"FORMAT_TYPE_IPV4":        8,
+		"FORMAT_TYPE_IPV6":        9,
+	}
+)
+
+func (x FormatType) Enum() *FormatType {
+	p := new(FormatType)
+	*p = x
+	return p
+}
+
+func (x FormatType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FormatType) Descriptor() protoreflect.EnumDescriptor {
+	return file_constraint_proto_enumTypes[1].Descriptor()
+}
+
+func (FormatType) Type() protoreflect.EnumType {
+	return &file_constraint_proto_enumTypes[1]
+}
+
+func (x FormatType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FormatType.Descriptor instead.
+func (FormatType) EnumDescriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{1}
+}
+
+// Range constraint value
+type RangeConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min       *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max       *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Inclusive *bool    `protobuf:"varint,3,opt,name=inclusive,proto3,oneof" json:"inclusive,omitempty"` // defaults to true
+}
+
+func (x *RangeConstraint) Reset() {
+	*x = RangeConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_constraint_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeConstraint) ProtoMessage() {}
+
+func (x *RangeConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_constraint_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeConstraint.ProtoReflect.Descriptor instead.
+func (*RangeConstraint) Descriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RangeConstraint) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *RangeConstraint) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *RangeConstraint) GetInclusive() bool {
+	if x != nil && x.Inclusive != nil {
+		return *x.Inclusive
+	}
+	return false
+}
+
+// Enum constraint values
+type EnumConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *EnumConstraint) Reset() {
+	*x = EnumConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_constraint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumConstraint) ProtoMessage() {}
+
+func (x *EnumConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_constraint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumConstraint.ProtoReflect.Descriptor instead.
+func (*EnumConstraint) Descriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnumConstraint) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Constraint value (oneof based on constraint type)
+type ConstraintValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*ConstraintValue_IntValue
+	//	*ConstraintValue_StringValue
+	//	*ConstraintValue_FormatValue
+	//	*ConstraintValue_RangeValue
+	//	*ConstraintValue_EnumValue
+	Value isConstraintValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *ConstraintValue) Reset() {
+	*x = ConstraintValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_constraint_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConstraintValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstraintValue) ProtoMessage() {}
+
+func (x *ConstraintValue) ProtoReflect() protoreflect.Message {
+	mi := &file_constraint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstraintValue.ProtoReflect.Descriptor instead.
+func (*ConstraintValue) Descriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *ConstraintValue) GetValue() isConstraintValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *ConstraintValue) GetIntValue() int32 {
+	if x, ok := x.GetValue().(*ConstraintValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *ConstraintValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*ConstraintValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ConstraintValue) GetFormatValue() FormatType {
+	if x, ok := x.GetValue().(*ConstraintValue_FormatValue); ok {
+		return x.FormatValue
+	}
+	return FormatType_FORMAT_TYPE_UNSPECIFIED
+}
+
+func (x *ConstraintValue) GetRangeValue() *RangeConstraint {
+	if x, ok := x.GetValue().(*ConstraintValue_RangeValue); ok {
+		return x.RangeValue
+	}
+	return nil
+}
+
+func (x *ConstraintValue) GetEnumValue() *EnumConstraint {
+	if x, ok := x.GetValue().(*ConstraintValue_EnumValue); ok {
+		return x.EnumValue
+	}
+	return nil
+}
+
+type isConstraintValue_Value interface {
+	isConstraintValue_Value()
+}
+
+type ConstraintValue_IntValue struct {
+	IntValue int32 `protobuf:"varint,1,opt,name=int_value,json=intValue,proto3,oneof"` // for min_length, max_length
+}
+
+type ConstraintValue_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3,oneof"` // for pattern
+}
+
+type ConstraintValue_FormatValue struct {
+	FormatValue FormatType `protobuf:"varint,3,opt,name=format_value,json=formatValue,proto3,enum=astra.v1.FormatType,oneof"` // for format
+}
+
+type ConstraintValue_RangeValue struct {
+	RangeValue *RangeConstraint `protobuf:"bytes,4,opt,name=range_value,json=rangeValue,proto3,oneof"` // for range
+}
+
+type ConstraintValue_EnumValue struct {
+	EnumValue *EnumConstraint `protobuf:"bytes,5,opt,name=enum_value,json=enumValue,proto3,oneof"` // for enum
+}
+
+func (*ConstraintValue_IntValue) isConstraintValue_Value() {}
+
+func (*ConstraintValue_StringValue) isConstraintValue_Value() {}
+
+func (*ConstraintValue_FormatValue) isConstraintValue_Value() {}
+
+func (*ConstraintValue_RangeValue) isConstraintValue_Value() {}
+
+func (*ConstraintValue_EnumValue) isConstraintValue_Value() {}
+
+// Validation constraint for ASTRA fields and values
+type Constraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of constraint being applied
+	Type ConstraintType `protobuf:"varint,1,opt,name=type,proto3,enum=astra.v1.ConstraintType" json:"type,omitempty"`
+	// Constraint value (varies by constraint type)
+	Value *ConstraintValue `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	// Human-readable error message when constraint is violated
+	Message *string `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// Machine-readable error code for constraint violations
+	Code *string `protobuf:"bytes,4,opt,name=code,proto3,oneof" json:"code,omitempty"`
+}
+
+func (x *Constraint) Reset() {
+	*x = Constraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_constraint_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Constraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraint) ProtoMessage() {}
+
+func (x *Constraint) ProtoReflect() protoreflect.Message {
+	mi := &file_constraint_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constraint.ProtoReflect.Descriptor instead.
+func (*Constraint) Descriptor() ([]byte, []int) {
+	return file_constraint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Constraint) GetType() ConstraintType {
+	if x != nil {
+		return x.Type
+	}
+	return ConstraintType_CONSTRAINT_TYPE_UNSPECIFIED
+}
+
+func (x *Constraint) GetValue() *ConstraintValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Constraint) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *Constraint) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+var File_constraint_proto protoreflect.FileDescriptor
+
+var file_constraint_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x08, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x22, 0x80, 0x01, 0x0a,
+	0x0f, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61,
+	0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22,
+	0x28, 0x0a, 0x0e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc7,
+	0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xb7, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43,
+	0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f,
+	0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x5f,
+	0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x54,
+	0x45, 0x52, 0x4e, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41,
+	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10,
+	0x06, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14,
+	0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x4e, 0x55, 0x4d, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52,
+	0x41, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x10, 0x09, 0x2a, 0xf4, 0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d,
+	0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x52, 0x4c, 0x10,
+	0x03, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x18, 0x0a,
+	0x14, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54,
+	0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x07, 0x12, 0x14, 0x0a,
+	0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56,
+	0x34, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10, 0x09, 0x42, 0x62, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
+	0x2e, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x42, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72,
+	0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_constraint_proto_rawDescOnce sync.Once
+	file_constraint_proto_rawDescData = file_constraint_proto_rawDesc
+)
+
+func file_constraint_proto_rawDescGZIP() []byte {
+	file_constraint_proto_rawDescOnce.Do(func() {
+		file_constraint_proto_rawDescData = protoimpl.X.CompressGZIP(file_constraint_proto_rawDescData)
+	})
+	return file_constraint_proto_rawDescData
+}
+
+var file_constraint_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_constraint_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_constraint_proto_goTypes = []any{
+	(ConstraintType)(0),     // 0: astra.v1.ConstraintType
+	(FormatType)(0),         // 1: astra.v1.FormatType
+	(*RangeConstraint)(nil), // 2: astra.v1.RangeConstraint
+	(*EnumConstraint)(nil),  // 3: astra.v1.EnumConstraint
+	(*ConstraintValue)(nil), // 4: astra.v1.ConstraintValue
+	(*Constraint)(nil),      // 5: astra.v1.Constraint
+}
+var file_constraint_proto_depIdxs = []int32{
+	1, // 0: astra.v1.ConstraintValue.format_value:type_name -> astra.v1.FormatType
+	2, // 1: astra.v1.ConstraintValue.range_value:type_name -> astra.v1.RangeConstraint
+	3, // 2: astra.v1.ConstraintValue.enum_value:type_name -> astra.v1.EnumConstraint
+	0, // 3: astra.v1.Constraint.type:type_name -> astra.v1.ConstraintType
+	4, // 4: astra.v1.Constraint.value:type_name -> astra.v1.ConstraintValue
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_constraint_proto_init() }
+func file_constraint_proto_init() {
+	if File_constraint_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_constraint_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RangeConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_constraint_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*EnumConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_constraint_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ConstraintValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_constraint_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Constraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_constraint_proto_msgTypes[0].OneofWrappers = []any{}
+	file_constraint_proto_msgTypes[2].OneofWrappers = []any{
+		(*ConstraintValue_IntValue)(nil),
+		(*ConstraintValue_StringValue)(nil),
+		(*ConstraintValue_FormatValue)(nil),
+		(*ConstraintValue_RangeValue)(nil),
+		(*ConstraintValue_EnumValue)(nil),
+	}
+	file_constraint_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_constraint_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_constraint_proto_goTypes,
+		DependencyIndexes: file_constraint_proto_depIdxs,
+		EnumInfos:         file_constraint_proto_enumTypes,
+		MessageInfos:      file_constraint_proto_msgTypes,
+	}.Build()
+	File_constraint_proto = out.File
+	file_constraint_proto_rawDesc = nil
+	file_constraint_proto_goTypes = nil
+	file_constraint_proto_depIdxs = nil
+}
@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: conversation.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Current status of the conversation
+type ConversationStatus int32
+
+const (
+	ConversationStatus_CONVERSATION_STATUS_UNSPECIFIED ConversationStatus = 0
+	ConversationStatus_CONVERSATION_STATUS_ACTIVE      ConversationStatus = 1
+	ConversationStatus_CONVERSATION_STATUS_PAUSED      ConversationStatus = 2
+	ConversationStatus_CONVERSATION_STATUS_COMPLETED   ConversationStatus = 3
+	ConversationStatus_CONVERSATION_STATUS_FAILED      ConversationStatus = 4
+	ConversationStatus_CONVERSATION_STATUS_CANCELLED   ConversationStatus = 5
+)
+
+// Enum value maps for ConversationStatus.
+var (
+	ConversationStatus_name = map[int32]string{
+		0: "CONVERSATION_STATUS_UNSPECIFIED",
+		1: "CONVERSATION_STATUS_ACTIVE",
+		2: "CONVERSATION_STATUS_PAUSED",
+		3: "CONVERSATION_STATUS_COMPLETED",
+		4: "CONVERSATION_STATUS_FAILED",
+		5: "CONVERSATION_STATUS_CANCELLED",
+	}
+	ConversationStatus_value = map[string]int32{
+		"CONVERSATION_STATUS_UNSPECIFIED": 0,
+		"CONVERSATION_STATUS_ACTIVE":      1,
+		"CONVERSATION_STATUS_PAUSED":      2,
+		"CONVERSATION_STATUS_COMPLETED":   3,
+		"CONVERSATION_STATUS_FAILED":      4,
+		"CONVERSATION_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x ConversationStatus) Enum() *ConversationStatus {
+	p := new(ConversationStatus)
+	*p = x
+	return p
+}
+
+func (x ConversationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConversationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_conversation_proto_enumTypes[0].Descriptor()
+}
+
+func (ConversationStatus) Type() protoreflect.EnumType {
+	return &file_conversation_proto_enumTypes[0]
+}
+
+func (x ConversationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConversationStatus.Descriptor instead.
+func (ConversationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_conversation_proto_rawDescGZIP(), []int{0}
+}
+
+// Union type for all possible acts
+type ConversationAct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Act:
+	//	*ConversationAct_Ask
+	//	*ConversationAct_Fact
+	//	*ConversationAct_Confirm
+	//	*ConversationAct_Commit
+	//	*ConversationAct_Error
+	Act isConversationAct_Act `protobuf_oneof:"act"`
+}
+
+func (x *ConversationAct) Reset() {
+	*x = ConversationAct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationAct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationAct) ProtoMessage() {}
+
+func (x *ConversationAct) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationAct.ProtoReflect.Descriptor instead.
+func (*ConversationAct) Descriptor() ([]byte, []int) {
+	return file_conversation_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *ConversationAct) GetAct() isConversationAct_Act {
+	if m != nil {
+		return m.Act
+	}
+	return nil
+}
+
+func (x *ConversationAct) GetAsk() *Ask {
+	if x, ok := x.GetAct().(*ConversationAct_Ask); ok {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *ConversationAct) GetFact() *Fact {
+	if x, ok := x.GetAct().(*ConversationAct_Fact); ok {
+		return x.Fact
+	}
+	return nil
+}
+
+func (x *ConversationAct) GetConfirm() *Confirm {
+	if x, ok := x.GetAct().(*ConversationAct_Confirm); ok {
+		return x.Confirm
+	}
+	return nil
+}
+
+func (x *ConversationAct) GetCommit() *Commit {
+	if x, ok := x.GetAct().(*ConversationAct_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+func (x *ConversationAct) GetError() *Error {
+	if x, ok := x.GetAct().(*ConversationAct_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isConversationAct_Act interface {
+	isConversationAct_Act()
+}
+
+type ConversationAct_Ask struct {
+	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+}
+
+type ConversationAct_Fact struct {
+	Fact *Fact `protobuf:"bytes,2,opt,name=fact,proto3,oneof"`
+}
+
+type ConversationAct_Confirm struct {
+	Confirm *Confirm `protobuf:"bytes,3,opt,name=confirm,proto3,oneof"`
+}
+
+type ConversationAct_Commit struct {
+	Commit *Commit `protobuf:"bytes,4,opt,name=commit,proto3,oneof"`
+}
+
+type ConversationAct_Error struct {
+	Error *Error `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+}
+
+func (*ConversationAct_Ask) isConversationAct_Act() {}
+
+func (*ConversationAct_Fact) isConversationAct_Act() {}
+
+func (*ConversationAct_Confirm) isConversationAct_Act() {}
+
+func (*ConversationAct_Commit) isConversationAct_Act() {}
+
+func (*ConversationAct_Error) isConversationAct_Act() {}
+
+// Conversation context and session information
+type ConversationContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Session identifier
+	SessionId *string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	// User agent or client information
+	UserAgent *string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	// Client IP address
+	IpAddress *string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`
+	// How the conversation was initiated
+	Referrer *string `protobuf:"bytes,4,opt,name=referrer,proto3,oneof" json:"referrer,omitempty"`
+	// Additional context properties
+	AdditionalProperties *structpb.Struct `protobuf:"bytes,5,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *ConversationContext) Reset() {
+	*x = ConversationContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationContext) ProtoMessage() {}
+
+func (x *ConversationContext) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationContext.ProtoReflect.Descriptor instead.
+func (*ConversationContext) Descriptor() ([]byte, []int) {
+	return file_conversation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConversationContext) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *ConversationContext) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *ConversationContext) GetIpAddress() string {
+	if x != nil && x.IpAddress != nil {
+		return *x.IpAddress
+	}
+	return ""
+}
+
+func (x *ConversationContext) GetReferrer() string {
+	if x != nil && x.Referrer != nil {
+		return *x.Referrer
+	}
+	return ""
+}
+
+func (x *ConversationContext) GetAdditionalProperties() *structpb.Struct {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+// Additional conversation metadata
+type ConversationMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total conversation duration in milliseconds
+	TotalDurationMs *int64 `protobuf:"varint,1,opt,name=total_duration_ms,json=totalDurationMs,proto3,oneof" json:"total_duration_ms,omitempty"`
+	// Total number of acts in the conversation
+	ActCount *int32 `protobuf:"varint,2,opt,name=act_count,json=actCount,proto3,oneof" json:"act_count,omitempty"`
+	// Number of errors that occurred
+	ErrorCount *int32 `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3,oneof" json:"error_count,omitempty"`
+	// Number of successful commits
+	CommitCount *int32 `protobuf:"varint,4,opt,name=commit_count,json=commitCount,proto3,oneof" json:"commit_count,omitempty"`
+	// Average confidence score across all acts
+	AvgConfidence *float64 `protobuf:"fixed64,5,opt,name=avg_confidence,json=avgConfidence,proto3,oneof" json:"avg_confidence,omitempty"`
+	// Additional metadata properties
+	AdditionalProperties *structpb.Struct `protobuf:"bytes,6,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *ConversationMetadata) Reset() {
+	*x = ConversationMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationMetadata) ProtoMessage() {}
+
+func (x *ConversationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationMetadata.ProtoReflect.Descriptor instead.
+func (*ConversationMetadata) Descriptor() ([]byte, []int) {
+	return file_conversation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConversationMetadata) GetTotalDurationMs() int64 {
+	if x != nil && x.TotalDurationMs != nil {
+		return *x.TotalDurationMs
+	}
+	return 0
+}
+
+func (x *ConversationMetadata) GetActCount() int32 {
+	if x != nil && x.ActCount != nil {
+		return *x.ActCount
+	}
+	return 0
+}
+
+func (x *ConversationMetadata) GetErrorCount() int32 {
+	if x != nil && x.ErrorCount != nil {
+		return *x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ConversationMetadata) GetCommitCount() int32 {
+	if x != nil && x.CommitCount != nil {
+		return *x.CommitCount
+	}
+	return 0
+}
+
+func (x *ConversationMetadata) GetAvgConfidence() float64 {
+	if x != nil && x.AvgConfidence != nil {
+		return *x.AvgConfidence
+	}
+	return 0
+}
+
+func (x *ConversationMetadata) GetAdditionalProperties() *structpb.Struct {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+// Complete ASTRA conversation container with acts and metadata
+type Conversation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier for this conversation
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// List of conversation participants
+	Participants []*Participant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Ordered sequence of acts in this conversation
+	Acts []*ConversationAct `protobuf:"bytes,3,rep,name=acts,proto3" json:"acts,omitempty"`
+	// When the conversation started
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
+	// When the conversation ended
+	EndedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`
+	// Current status of the conversation
+	Status ConversationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=astra.v1.ConversationStatus" json:"status,omitempty"` // defaults to ACTIVE
+	// Primary communication channel for this conversation
+	Channel *string `protobuf:"bytes,7,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	// Business schema identifier used for this conversation
+	Schema *string `protobuf:"bytes,8,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	// Conversation context and session information
+	Context *ConversationContext `protobuf:"bytes,9,opt,name=context,proto3,oneof" json:"context,omitempty"`
+	// Final computed state of all entities after processing all acts
+	FinalState *structpb.Struct `protobuf:"bytes,10,opt,name=final_state,json=finalState,proto3,oneof" json:"final_state,omitempty"`
+	// Additional conversation metadata
+	Metadata *ConversationMetadata `protobuf:"bytes,11,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	// ASTRA schema version this conversation was written against
+	SchemaVersion *string `protobuf:"bytes,12,opt,name=schema_version,json=schemaVersion,proto3,oneof" json:"schema_version,omitempty"`
+	// Time budget for the conversation in milliseconds (SLA)
+	TimeBudgetMs *int64 `protobuf:"varint,13,opt,name=time_budget_ms,json=timeBudgetMs,proto3,oneof" json:"time_budget_ms,omitempty"`
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conversation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_conversation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetParticipants() []*Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Conversation) GetActs() []*ConversationAct {
+	if x != nil {
+		return x.Acts
+	}
+	return nil
+}
+
+func (x *Conversation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Conversation) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *Conversation) GetStatus() ConversationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConversationStatus_CONVERSATION_STATUS_UNSPECIFIED
+}
+
+func (x *Conversation) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *Conversation) GetSchema() string {
+	if x != nil && x.Schema != nil {
+		return *x.Schema
+	}
+	return ""
+}
+
+func (x *Conversation) GetContext() *ConversationContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *Conversation) GetFinalState() *structpb.Struct {
+	if x != nil {
+		return x.FinalState
+	}
+	return nil
+}
+
+func (x *Conversation) GetMetadata() *ConversationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Conversation) GetSchemaVersion() string {
+	if x != nil && x.SchemaVersion != nil {
+		return *x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Conversation) GetTimeBudgetMs() int64 {
+	if x != nil && x.TimeBudgetMs != nil {
+		return *x.TimeBudgetMs
+	}
+	return 0
+}
+
+var File_conversation_proto protoreflect.FileDescriptor
+
+var file_conversation_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x11,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x09, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x66, 0x61,
+	0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xe5, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
+	0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x61, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2d,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x2a, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x61, 0x63, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x13, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x22, 0x89, 0x03, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x2f, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x08, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x15,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x8d, 0x06, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x0a, 0x04, 0x61, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x52, 0x04, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3e, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x48, 0x04, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x73, 0x74,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x06, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x6d, 0x73, 0x2a, 0xdf, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e,
+	0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x05, 0x42, 0x64, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73,
+	0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x7a,
+	0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x67, 0x6f,
+	0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72, 0x79, 0x73, 0x7a,
+	0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_conversation_proto_rawDescOnce sync.Once
+	file_conversation_proto_rawDescData = file_conversation_proto_rawDesc
+)
+
+func file_conversation_proto_rawDescGZIP() []byte {
+	file_conversation_proto_rawDescOnce.Do(func() {
+		file_conversation_proto_rawDescData = protoimpl.X.CompressGZIP(file_conversation_proto_rawDescData)
+	})
+	return file_conversation_proto_rawDescData
+}
+
+var file_conversation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_conversation_proto_goTypes = []any{
+	(ConversationStatus)(0),       // 0: astra.v1.ConversationStatus
+	(*ConversationAct)(nil),       // 1: astra.v1.ConversationAct
+	(*ConversationContext)(nil),   // 2: astra.v1.ConversationContext
+	(*ConversationMetadata)(nil),  // 3: astra.v1.ConversationMetadata
+	(*Conversation)(nil),          // 4: astra.v1.Conversation
+	(*Ask)(nil),                   // 5: astra.v1.Ask
+	(*Fact)(nil),                  // 6: astra.v1.Fact
+	(*Confirm)(nil),               // 7: astra.v1.Confirm
+	(*Commit)(nil),                // 8: astra.v1.Commit
+	(*Error)(nil),                 // 9: astra.v1.Error
+	(*structpb.Struct)(nil),       // 10: google.protobuf.Struct
+	(*Participant)(nil),           // 11: astra.v1.Participant
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_conversation_proto_depIdxs = []int32{
+	5,  // 0: astra.v1.ConversationAct.ask:type_name -> astra.v1.Ask
+	6,  // 1: astra.v1.ConversationAct.fact:type_name -> astra.v1.Fact
+	7,  // 2: astra.v1.ConversationAct.confirm:type_name -> astra.v1.Confirm
+	8,  // 3: astra.v1.ConversationAct.commit:type_name -> astra.v1.Commit
+	9,  // 4: astra.v1.ConversationAct.error:type_name -> astra.v1.Error
+	10, // 5: astra.v1.ConversationContext.additional_properties:type_name -> google.protobuf.Struct
+	10, // 6: astra.v1.ConversationMetadata.additional_properties:type_name -> google.protobuf.Struct
+	11, // 7: astra.v1.Conversation.participants:type_name -> astra.v1.Participant
+	1,  // 8: astra.v1.Conversation.acts:type_name -> astra.v1.ConversationAct
+	12, // 9: astra.v1.Conversation.started_at:type_name -> google.protobuf.Timestamp
+	12, // 10: astra.v1.Conversation.ended_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: astra.v1.Conversation.status:type_name -> astra.v1.ConversationStatus
+	2,  // 12: astra.v1.Conversation.context:type_name -> astra.v1.ConversationContext
+	10, // 13: astra.v1.Conversation.final_state:type_name -> google.protobuf.Struct
+	3,  // 14: astra.v1.Conversation.metadata:type_name -> astra.v1.ConversationMetadata
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_conversation_proto_init() }
+func file_conversation_proto_init() {
+	if File_conversation_proto != nil {
+		return
+	}
+	file_participant_proto_init()
+	file_ask_proto_init()
+	file_fact_proto_init()
+	file_confirm_proto_init()
+	file_commit_proto_init()
+	file_error_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_conversation_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ConversationAct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversation_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ConversationContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversation_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ConversationMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conversation_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Conversation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_conversation_proto_msgTypes[0].OneofWrappers = []any{
+		(*ConversationAct_Ask)(nil),
+		(*ConversationAct_Fact)(nil),
+		(*ConversationAct_Confirm)(nil),
+		(*ConversationAct_Commit)(nil),
+		(*ConversationAct_Error)(nil),
+	}
+	file_conversation_proto_msgTypes[1].OneofWrappers = []any{}
+	file_conversation_proto_msgTypes[2].OneofWrappers = []any{}
+	file_conversation_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_conversation_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_conversation_proto_goTypes,
+		DependencyIndexes: file_conversation_proto_depIdxs,
+		EnumInfos:         file_conversation_proto_enumTypes,
+		MessageInfos:      file_conversation_proto_msgTypes,
+	}.Build()
+	File_conversation_proto = out.File
+	file_conversation_proto_rawDesc = nil
+	file_conversation_proto_goTypes = nil
+	file_conversation_proto_depIdxs = nil
+}
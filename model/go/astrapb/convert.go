@@ -0,0 +1,1077 @@
+// Package astrapb contains the protobuf messages generated from the ASTRA IDL
+// in idl/protobuf, along with conversions to and from the astra Go types.
+//
+// Conversions round-trip every field of the Go types. Pointer fields map to
+// proto3 optional fields, and optional enums without a proto3 optional marker
+// map nil to the UNSPECIFIED value. Free-form values (fact values, metadata,
+// details) are carried as google.protobuf.Value and Struct, so numbers come
+// back as float64 exactly as they do when decoding JSON. Structured entity
+// references come back as astra.Entity values, and timestamps come back in UTC.
+// Enum values must be ones the IDL defines, so acts using sources added with
+// astra.RegisterSource cannot be converted, and neither can signed acts, as
+// the IDL has no field for act signatures.
+package astrapb
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	astra "github.com/pryszm/astra-model-go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// Conversations
+// ============================================================================
+
+// ConversationToProto converts a conversation and all of its acts to protobuf
+func ConversationToProto(c astra.Conversation) (*Conversation, error) {
+	pb := &Conversation{
+		Id:            c.ID,
+		Channel:       c.Channel,
+		Schema:        c.Schema,
+		SchemaVersion: c.SchemaVersion,
+		TimeBudgetMs:  c.TimeBudgetMs,
+	}
+
+	for i, participant := range c.Participants {
+		participantPB, err := ParticipantToProto(participant)
+		if err != nil {
+			return nil, fmt.Errorf("participant %d: %w", i, err)
+		}
+		pb.Participants = append(pb.Participants, participantPB)
+	}
+	for i, act := range c.Acts {
+		actPB, err := ActToProto(act)
+		if err != nil {
+			return nil, fmt.Errorf("act %d: %w", i, err)
+		}
+		pb.Acts = append(pb.Acts, actPB)
+	}
+
+	if c.StartedAt != nil {
+		pb.StartedAt = timestamppb.New(*c.StartedAt)
+	}
+	if c.EndedAt != nil {
+		pb.EndedAt = timestamppb.New(*c.EndedAt)
+	}
+
+	var err error
+	if pb.Status, err = toEnumOrUnspecified[ConversationStatus](ConversationStatus_value, "CONVERSATION_STATUS_", c.Status); err != nil {
+		return nil, err
+	}
+	if pb.FinalState, err = toStruct(c.FinalState); err != nil {
+		return nil, fmt.Errorf("final_state: %w", err)
+	}
+
+	if c.Context != nil {
+		pb.Context = &ConversationContext{
+			SessionId: c.Context.SessionID,
+			UserAgent: c.Context.UserAgent,
+			IpAddress: c.Context.IPAddress,
+			Referrer:  c.Context.Referrer,
+		}
+		if pb.Context.AdditionalProperties, err = toStruct(c.Context.AdditionalProperties); err != nil {
+			return nil, fmt.Errorf("context: %w", err)
+		}
+	}
+
+	if c.Metadata != nil {
+		pb.Metadata = &ConversationMetadata{
+			TotalDurationMs: c.Metadata.TotalDurationMs,
+			AvgConfidence:   c.Metadata.AvgConfidence,
+		}
+		if pb.Metadata.ActCount, err = toInt32Ptr("act_count", c.Metadata.ActCount); err != nil {
+			return nil, err
+		}
+		if pb.Metadata.ErrorCount, err = toInt32Ptr("error_count", c.Metadata.ErrorCount); err != nil {
+			return nil, err
+		}
+		if pb.Metadata.CommitCount, err = toInt32Ptr("commit_count", c.Metadata.CommitCount); err != nil {
+			return nil, err
+		}
+		if pb.Metadata.AdditionalProperties, err = toStruct(c.Metadata.AdditionalProperties); err != nil {
+			return nil, fmt.Errorf("metadata: %w", err)
+		}
+	}
+
+	return pb, nil
+}
+
+// ConversationFromProto converts a protobuf conversation back to the Go type
+func ConversationFromProto(pb *Conversation) (astra.Conversation, error) {
+	var c astra.Conversation
+	if pb == nil {
+		return c, fmt.Errorf("conversation cannot be nil")
+	}
+
+	c.ID = pb.Id
+	c.Channel = pb.Channel
+	c.Schema = pb.Schema
+	c.SchemaVersion = pb.SchemaVersion
+	c.TimeBudgetMs = pb.TimeBudgetMs
+
+	for i, participantPB := range pb.Participants {
+		participant, err := ParticipantFromProto(participantPB)
+		if err != nil {
+			return c, fmt.Errorf("participant %d: %w", i, err)
+		}
+		c.Participants = append(c.Participants, participant)
+	}
+	c.Acts = make([]astra.ConversationAct, 0, len(pb.Acts))
+	for i, actPB := range pb.Acts {
+		act, err := ActFromProto(actPB)
+		if err != nil {
+			return c, fmt.Errorf("act %d: %w", i, err)
+		}
+		c.Acts = append(c.Acts, act)
+	}
+
+	if pb.StartedAt != nil {
+		startedAt := pb.StartedAt.AsTime()
+		c.StartedAt = &startedAt
+	}
+	if pb.EndedAt != nil {
+		endedAt := pb.EndedAt.AsTime()
+		c.EndedAt = &endedAt
+	}
+
+	var err error
+	if c.Status, err = fromEnumOrNil[astra.ConversationStatus](ConversationStatus_name, "CONVERSATION_STATUS_", pb.Status); err != nil {
+		return c, err
+	}
+	c.FinalState = fromStruct(pb.FinalState)
+
+	if pb.Context != nil {
+		c.Context = &astra.ConversationContext{
+			SessionID:            pb.Context.SessionId,
+			UserAgent:            pb.Context.UserAgent,
+			IPAddress:            pb.Context.IpAddress,
+			Referrer:             pb.Context.Referrer,
+			AdditionalProperties: fromStruct(pb.Context.AdditionalProperties),
+		}
+	}
+
+	if pb.Metadata != nil {
+		c.Metadata = &astra.ConversationMetadata{
+			TotalDurationMs:      pb.Metadata.TotalDurationMs,
+			ActCount:             fromInt32Ptr(pb.Metadata.ActCount),
+			ErrorCount:           fromInt32Ptr(pb.Metadata.ErrorCount),
+			CommitCount:          fromInt32Ptr(pb.Metadata.CommitCount),
+			AvgConfidence:        pb.Metadata.AvgConfidence,
+			AdditionalProperties: fromStruct(pb.Metadata.AdditionalProperties),
+		}
+	}
+
+	return c, nil
+}
+
+// ============================================================================
+// Acts
+// ============================================================================
+
+// ActToProto converts any ConversationAct to the ConversationAct union message
+func ActToProto(act astra.ConversationAct) (*ConversationAct, error) {
+	switch a := act.(type) {
+	case astra.Ask:
+		pb, err := askToProto(a)
+		if err != nil {
+			return nil, err
+		}
+		return &ConversationAct{Act: &ConversationAct_Ask{Ask: pb}}, nil
+	case astra.Fact:
+		pb, err := factToProto(a)
+		if err != nil {
+			return nil, err
+		}
+		return &ConversationAct{Act: &ConversationAct_Fact{Fact: pb}}, nil
+	case astra.Confirm:
+		pb, err := confirmToProto(a)
+		if err != nil {
+			return nil, err
+		}
+		return &ConversationAct{Act: &ConversationAct_Confirm{Confirm: pb}}, nil
+	case astra.Commit:
+		pb, err := commitToProto(a)
+		if err != nil {
+			return nil, err
+		}
+		return &ConversationAct{Act: &ConversationAct_Commit{Commit: pb}}, nil
+	case astra.Error:
+		pb, err := errorToProto(a)
+		if err != nil {
+			return nil, err
+		}
+		return &ConversationAct{Act: &ConversationAct_Error{Error: pb}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported act type: %T", act)
+	}
+}
+
+// ActFromProto converts a ConversationAct union message to the Go act it holds
+func ActFromProto(pb *ConversationAct) (astra.ConversationAct, error) {
+	switch a := pb.GetAct().(type) {
+	case *ConversationAct_Ask:
+		return askFromProto(a.Ask)
+	case *ConversationAct_Fact:
+		return factFromProto(a.Fact)
+	case *ConversationAct_Confirm:
+		return confirmFromProto(a.Confirm)
+	case *ConversationAct_Commit:
+		return commitFromProto(a.Commit)
+	case *ConversationAct_Error:
+		return errorFromProto(a.Error)
+	default:
+		return nil, fmt.Errorf("conversation act has no act set")
+	}
+}
+
+// baseActToProto converts the properties shared by every act
+func baseActToProto(act astra.Act) (*Act, error) {
+	if act.Signature != nil {
+		return nil, fmt.Errorf("act %s: signatures cannot be represented in protobuf", act.ID)
+	}
+
+	pb := &Act{
+		Id:         act.ID,
+		Timestamp:  timestamppb.New(act.Timestamp),
+		Speaker:    act.Speaker,
+		Confidence: act.Confidence,
+	}
+
+	var err error
+	if pb.Type, err = toEnum[ActType](ActType_value, "ACT_TYPE_", act.Type); err != nil {
+		return nil, err
+	}
+	if pb.Source, err = toEnumPtr[Source](Source_value, "SOURCE_", act.Source); err != nil {
+		return nil, err
+	}
+
+	if act.Metadata != nil {
+		pb.Metadata = &ActMetadata{
+			Channel:          act.Metadata.Channel,
+			Language:         act.Metadata.Language,
+			OriginalText:     act.Metadata.OriginalText,
+			ProcessingTimeMs: act.Metadata.ProcessingTimeMs,
+		}
+		if pb.Metadata.AdditionalProperties, err = toStruct(act.Metadata.AdditionalProperties); err != nil {
+			return nil, fmt.Errorf("act metadata: %w", err)
+		}
+	}
+
+	return pb, nil
+}
+
+// baseActFromProto converts the properties shared by every act
+func baseActFromProto(pb *Act) (astra.Act, error) {
+	var act astra.Act
+	if pb == nil {
+		return act, fmt.Errorf("act properties are required")
+	}
+
+	act.ID = pb.Id
+	act.Timestamp = pb.Timestamp.AsTime()
+	act.Speaker = pb.Speaker
+	act.Confidence = pb.Confidence
+
+	var err error
+	if act.Type, err = fromEnum[astra.ActType](ActType_name, "ACT_TYPE_", pb.Type); err != nil {
+		return act, err
+	}
+	if act.Source, err = fromEnumPtr[astra.Source](Source_name, "SOURCE_", pb.Source); err != nil {
+		return act, err
+	}
+
+	if pb.Metadata != nil {
+		act.Metadata = &astra.ActMetadata{
+			Channel:              pb.Metadata.Channel,
+			Language:             pb.Metadata.Language,
+			OriginalText:         pb.Metadata.OriginalText,
+			ProcessingTimeMs:     pb.Metadata.ProcessingTimeMs,
+			AdditionalProperties: fromStruct(pb.Metadata.AdditionalProperties),
+		}
+	}
+
+	return act, nil
+}
+
+// askToProto converts an Ask act
+func askToProto(a astra.Ask) (*Ask, error) {
+	act, err := baseActToProto(a.Act)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Ask{
+		Act:      act,
+		Field:    a.Field,
+		Prompt:   a.Prompt,
+		Required: a.Required,
+	}
+
+	for i, constraint := range a.Constraints {
+		constraintPB, err := constraintToProto(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("constraint %d: %w", i, err)
+		}
+		pb.Constraints = append(pb.Constraints, constraintPB)
+	}
+	if pb.ExpectedType, err = toEnumPtr[ExpectedType](ExpectedType_value, "EXPECTED_TYPE_", a.ExpectedType); err != nil {
+		return nil, err
+	}
+	if pb.RetryCount, err = toInt32Ptr("retry_count", a.RetryCount); err != nil {
+		return nil, err
+	}
+	if pb.MaxRetries, err = toInt32Ptr("max_retries", a.MaxRetries); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+// askFromProto converts an Ask act
+func askFromProto(pb *Ask) (astra.Ask, error) {
+	var a astra.Ask
+	act, err := baseActFromProto(pb.GetAct())
+	if err != nil {
+		return a, err
+	}
+	a.Act = act
+	a.Field = pb.Field
+	a.Prompt = pb.Prompt
+	a.Required = pb.Required
+	a.RetryCount = fromInt32Ptr(pb.RetryCount)
+	a.MaxRetries = fromInt32Ptr(pb.MaxRetries)
+
+	for i, constraintPB := range pb.Constraints {
+		constraint, err := constraintFromProto(constraintPB)
+		if err != nil {
+			return a, fmt.Errorf("constraint %d: %w", i, err)
+		}
+		a.Constraints = append(a.Constraints, constraint)
+	}
+	if a.ExpectedType, err = fromEnumPtr[astra.ExpectedType](ExpectedType_name, "EXPECTED_TYPE_", pb.ExpectedType); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+// factToProto converts a Fact act
+func factToProto(f astra.Fact) (*Fact, error) {
+	act, err := baseActToProto(f.Act)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Fact{
+		Act:              act,
+		Field:            f.Field,
+		ValidationErrors: f.ValidationErrors,
+	}
+
+	if pb.Entity, err = EntityRefToProto(f.Entity); err != nil {
+		return nil, err
+	}
+	if pb.Value, err = toValue(f.Value); err != nil {
+		return nil, fmt.Errorf("fact value: %w", err)
+	}
+	if f.PreviousValue != nil {
+		if pb.PreviousValue, err = toValue(f.PreviousValue); err != nil {
+			return nil, fmt.Errorf("fact previous_value: %w", err)
+		}
+	}
+	if pb.Operation, err = toEnumOrUnspecified[FieldOperation](FieldOperation_value, "FIELD_OPERATION_", f.Operation); err != nil {
+		return nil, err
+	}
+	if pb.ValidationStatus, err = toEnumOrUnspecified[ValidationStatus](ValidationStatus_value, "VALIDATION_STATUS_", f.ValidationStatus); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+// factFromProto converts a Fact act
+func factFromProto(pb *Fact) (astra.Fact, error) {
+	var f astra.Fact
+	act, err := baseActFromProto(pb.GetAct())
+	if err != nil {
+		return f, err
+	}
+	f.Act = act
+	f.Field = pb.Field
+	f.Value = pb.Value.AsInterface()
+	f.ValidationErrors = pb.ValidationErrors
+	if pb.PreviousValue != nil {
+		f.PreviousValue = pb.PreviousValue.AsInterface()
+	}
+
+	if f.Entity, err = EntityRefFromProto(pb.Entity); err != nil {
+		return f, err
+	}
+	if f.Operation, err = fromEnumOrNil[astra.FieldOperation](FieldOperation_name, "FIELD_OPERATION_", pb.Operation); err != nil {
+		return f, err
+	}
+	if f.ValidationStatus, err = fromEnumOrNil[astra.ValidationStatus](ValidationStatus_name, "VALIDATION_STATUS_", pb.ValidationStatus); err != nil {
+		return f, err
+	}
+
+	return f, nil
+}
+
+// confirmToProto converts a Confirm act
+func confirmToProto(c astra.Confirm) (*Confirm, error) {
+	act, err := baseActToProto(c.Act)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Confirm{
+		Act:             act,
+		Summary:         c.Summary,
+		Awaiting:        c.Awaiting,
+		Confirmed:       c.Confirmed,
+		FieldsConfirmed: c.FieldsConfirmed,
+		RejectionReason: c.RejectionReason,
+		TimeoutMs:       c.TimeoutMs,
+	}
+
+	if pb.Entity, err = EntityRefToProto(c.Entity); err != nil {
+		return nil, err
+	}
+	if pb.ConfirmationMethod, err = toEnumPtr[ConfirmationMethod](ConfirmationMethod_value, "CONFIRMATION_METHOD_", c.ConfirmationMethod); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+// confirmFromProto converts a Confirm act
+func confirmFromProto(pb *Confirm) (astra.Confirm, error) {
+	var c astra.Confirm
+	act, err := baseActFromProto(pb.GetAct())
+	if err != nil {
+		return c, err
+	}
+	c.Act = act
+	c.Summary = pb.Summary
+	c.Awaiting = pb.Awaiting
+	c.Confirmed = pb.Confirmed
+	c.FieldsConfirmed = pb.FieldsConfirmed
+	c.RejectionReason = pb.RejectionReason
+	c.TimeoutMs = pb.TimeoutMs
+
+	if c.Entity, err = EntityRefFromProto(pb.Entity); err != nil {
+		return c, err
+	}
+	if c.ConfirmationMethod, err = fromEnumPtr[astra.ConfirmationMethod](ConfirmationMethod_name, "CONFIRMATION_METHOD_", pb.ConfirmationMethod); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// commitToProto converts a Commit act
+func commitToProto(c astra.Commit) (*Commit, error) {
+	act, err := baseActToProto(c.Act)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Commit{
+		Act:            act,
+		System:         c.System,
+		TransactionId:  c.TransactionID,
+		IdempotencyKey: c.IdempotencyKey,
+	}
+
+	if pb.Entity, err = EntityRefToProto(c.Entity); err != nil {
+		return nil, err
+	}
+	if pb.Action, err = toEnum[CommitAction](CommitAction_value, "COMMIT_ACTION_", c.Action); err != nil {
+		return nil, err
+	}
+	if pb.Status, err = toEnumOrUnspecified[CommitStatus](CommitStatus_value, "COMMIT_STATUS_", c.Status); err != nil {
+		return nil, err
+	}
+	if pb.RetryCount, err = toInt32Ptr("retry_count", c.RetryCount); err != nil {
+		return nil, err
+	}
+	if pb.MaxRetries, err = toInt32Ptr("max_retries", c.MaxRetries); err != nil {
+		return nil, err
+	}
+	if pb.RollbackInfo, err = toStruct(c.RollbackInfo); err != nil {
+		return nil, fmt.Errorf("rollback_info: %w", err)
+	}
+
+	if c.Error != nil {
+		pb.Error = &CommitError{
+			Code:        c.Error.Code,
+			Message:     c.Error.Message,
+			Recoverable: c.Error.Recoverable,
+		}
+		if pb.Error.Details, err = toStruct(c.Error.Details); err != nil {
+			return nil, fmt.Errorf("commit error details: %w", err)
+		}
+	}
+
+	return pb, nil
+}
+
+// commitFromProto converts a Commit act
+func commitFromProto(pb *Commit) (astra.Commit, error) {
+	var c astra.Commit
+	act, err := baseActFromProto(pb.GetAct())
+	if err != nil {
+		return c, err
+	}
+	c.Act = act
+	c.System = pb.System
+	c.TransactionID = pb.TransactionId
+	c.IdempotencyKey = pb.IdempotencyKey
+	c.RetryCount = fromInt32Ptr(pb.RetryCount)
+	c.MaxRetries = fromInt32Ptr(pb.MaxRetries)
+	c.RollbackInfo = fromStruct(pb.RollbackInfo)
+
+	if c.Entity, err = EntityRefFromProto(pb.Entity); err != nil {
+		return c, err
+	}
+	if c.Action, err = fromEnum[astra.CommitAction](CommitAction_name, "COMMIT_ACTION_", pb.Action); err != nil {
+		return c, err
+	}
+	if c.Status, err = fromEnumOrNil[astra.CommitStatus](CommitStatus_name, "COMMIT_STATUS_", pb.Status); err != nil {
+		return c, err
+	}
+
+	if pb.Error != nil {
+		c.Error = &astra.CommitError{
+			Code:        pb.Error.Code,
+			Message:     pb.Error.Message,
+			Details:     fromStruct(pb.Error.Details),
+			Recoverable: pb.Error.Recoverable,
+		}
+	}
+
+	return c, nil
+}
+
+// errorToProto converts an Error act
+func errorToProto(e astra.Error) (*Error, error) {
+	act, err := baseActToProto(e.Act)
+	if err != nil {
+		return nil, err
+	}
+	pb := &Error{
+		Act:          act,
+		Code:         e.Code,
+		Message:      e.Message,
+		Recoverable:  e.Recoverable,
+		RelatedActId: e.RelatedActID,
+		UserMessage:  e.UserMessage,
+		StackTrace:   e.StackTrace,
+	}
+
+	if pb.Severity, err = toEnumOrUnspecified[ErrorSeverity](ErrorSeverity_value, "ERROR_SEVERITY_", e.Severity); err != nil {
+		return nil, err
+	}
+	if pb.Category, err = toEnumPtr[ErrorCategory](ErrorCategory_value, "ERROR_CATEGORY_", e.Category); err != nil {
+		return nil, err
+	}
+	if pb.SuggestedAction, err = toEnumPtr[SuggestedAction](SuggestedAction_value, "SUGGESTED_ACTION_", e.SuggestedAction); err != nil {
+		return nil, err
+	}
+	if pb.Details, err = toStruct(e.Details); err != nil {
+		return nil, fmt.Errorf("error details: %w", err)
+	}
+
+	return pb, nil
+}
+
+// errorFromProto converts an Error act
+func errorFromProto(pb *Error) (astra.Error, error) {
+	var e astra.Error
+	act, err := baseActFromProto(pb.GetAct())
+	if err != nil {
+		return e, err
+	}
+	e.Act = act
+	e.Code = pb.Code
+	e.Message = pb.Message
+	e.Recoverable = pb.Recoverable
+	e.RelatedActID = pb.RelatedActId
+	e.UserMessage = pb.UserMessage
+	e.StackTrace = pb.StackTrace
+	e.Details = fromStruct(pb.Details)
+
+	if e.Severity, err = fromEnumOrNil[astra.ErrorSeverity](ErrorSeverity_name, "ERROR_SEVERITY_", pb.Severity); err != nil {
+		return e, err
+	}
+	if e.Category, err = fromEnumPtr[astra.ErrorCategory](ErrorCategory_name, "ERROR_CATEGORY_", pb.Category); err != nil {
+		return e, err
+	}
+	if e.SuggestedAction, err = fromEnumPtr[astra.SuggestedAction](SuggestedAction_name, "SUGGESTED_ACTION_", pb.SuggestedAction); err != nil {
+		return e, err
+	}
+
+	return e, nil
+}
+
+// ============================================================================
+// Constraints
+// ============================================================================
+
+// constraintToProto converts a constraint, mapping its value onto the
+// ConstraintValue variant used by the constraint's type
+func constraintToProto(c astra.Constraint) (*Constraint, error) {
+	pb := &Constraint{
+		Message: c.Message,
+		Code:    c.Code,
+	}
+
+	var err error
+	if pb.Type, err = toEnum[ConstraintType](ConstraintType_value, "CONSTRAINT_TYPE_", c.Type); err != nil {
+		return nil, err
+	}
+	if c.Value == nil {
+		return pb, nil
+	}
+
+	switch c.Type {
+	case astra.ConstraintTypeMinLength, astra.ConstraintTypeMaxLength:
+		limit, ok := toInt32(c.Value)
+		if !ok {
+			return nil, fmt.Errorf("%s constraint value must be an integer, got %T", c.Type, c.Value)
+		}
+		pb.Value = &ConstraintValue{Value: &ConstraintValue_IntValue{IntValue: limit}}
+	case astra.ConstraintTypePattern:
+		pattern, ok := c.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("pattern constraint value must be a string, got %T", c.Value)
+		}
+		pb.Value = &ConstraintValue{Value: &ConstraintValue_StringValue{StringValue: pattern}}
+	case astra.ConstraintTypeFormat:
+		var format astra.FormatType
+		switch v := c.Value.(type) {
+		case astra.FormatType:
+			format = v
+		case string:
+			format = astra.FormatType(v)
+		default:
+			return nil, fmt.Errorf("format constraint value must be a format type, got %T", c.Value)
+		}
+		formatPB, err := toEnum[FormatType](FormatType_value, "FORMAT_TYPE_", format)
+		if err != nil {
+			return nil, err
+		}
+		pb.Value = &ConstraintValue{Value: &ConstraintValue_FormatValue{FormatValue: formatPB}}
+	case astra.ConstraintTypeRange:
+		rangePB, err := rangeToProto(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		pb.Value = &ConstraintValue{Value: &ConstraintValue_RangeValue{RangeValue: rangePB}}
+	case astra.ConstraintTypeEnum:
+		values, err := toStrings(c.Value)
+		if err != nil {
+			return nil, fmt.Errorf("enum constraint value: %w", err)
+		}
+		pb.Value = &ConstraintValue{Value: &ConstraintValue_EnumValue{EnumValue: &EnumConstraint{Values: values}}}
+	default:
+		return nil, fmt.Errorf("%s constraint value of type %T cannot be represented in protobuf", c.Type, c.Value)
+	}
+
+	return pb, nil
+}
+
+// constraintFromProto converts a constraint. Length limits come back as int,
+// formats as astra.FormatType, ranges as astra.RangeConstraint, and enum values
+// as []string, matching the constraint builders.
+func constraintFromProto(pb *Constraint) (astra.Constraint, error) {
+	var c astra.Constraint
+	if pb == nil {
+		return c, fmt.Errorf("constraint cannot be nil")
+	}
+	c.Message = pb.Message
+	c.Code = pb.Code
+
+	var err error
+	if c.Type, err = fromEnum[astra.ConstraintType](ConstraintType_name, "CONSTRAINT_TYPE_", pb.Type); err != nil {
+		return c, err
+	}
+
+	switch v := pb.GetValue().GetValue().(type) {
+	case *ConstraintValue_IntValue:
+		c.Value = int(v.IntValue)
+	case *ConstraintValue_StringValue:
+		c.Value = v.StringValue
+	case *ConstraintValue_FormatValue:
+		if c.Value, err = fromEnum[astra.FormatType](FormatType_name, "FORMAT_TYPE_", v.FormatValue); err != nil {
+			return c, err
+		}
+	case *ConstraintValue_RangeValue:
+		c.Value = astra.RangeConstraint{
+			Min:       v.RangeValue.Min,
+			Max:       v.RangeValue.Max,
+			Inclusive: v.RangeValue.Inclusive,
+		}
+	case *ConstraintValue_EnumValue:
+		c.Value = v.EnumValue.Values
+	}
+
+	return c, nil
+}
+
+// rangeToProto converts a range constraint value, either the Go struct or the
+// object form decoded from JSON
+func rangeToProto(value interface{}) (*RangeConstraint, error) {
+	switch v := value.(type) {
+	case astra.RangeConstraint:
+		return &RangeConstraint{Min: v.Min, Max: v.Max, Inclusive: v.Inclusive}, nil
+	case *astra.RangeConstraint:
+		return &RangeConstraint{Min: v.Min, Max: v.Max, Inclusive: v.Inclusive}, nil
+	case map[string]interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var r astra.RangeConstraint
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, fmt.Errorf("invalid range constraint value: %w", err)
+		}
+		return &RangeConstraint{Min: r.Min, Max: r.Max, Inclusive: r.Inclusive}, nil
+	default:
+		return nil, fmt.Errorf("range constraint value must be a range, got %T", value)
+	}
+}
+
+// ============================================================================
+// Participants and Entities
+// ============================================================================
+
+// ParticipantToProto converts a participant to protobuf
+func ParticipantToProto(p astra.Participant) (*Participant, error) {
+	pb := &Participant{
+		Id:           p.ID,
+		Role:         p.Role,
+		Name:         p.Name,
+		Email:        p.Email,
+		Phone:        p.Phone,
+		ExternalId:   p.ExternalID,
+		System:       p.System,
+		Capabilities: p.Capabilities,
+		Permissions:  p.Permissions,
+	}
+
+	var err error
+	if pb.Type, err = toEnum[ParticipantType](ParticipantType_value, "PARTICIPANT_TYPE_", p.Type); err != nil {
+		return nil, err
+	}
+	if pb.Metadata, err = toStruct(p.Metadata); err != nil {
+		return nil, fmt.Errorf("participant metadata: %w", err)
+	}
+
+	if p.Preferences != nil {
+		pb.Preferences = &ParticipantPreferences{
+			Language:              p.Preferences.Language,
+			Timezone:              p.Preferences.Timezone,
+			CommunicationChannels: p.Preferences.CommunicationChannels,
+		}
+		if pb.Preferences.AdditionalProperties, err = toStruct(p.Preferences.AdditionalProperties); err != nil {
+			return nil, fmt.Errorf("participant preferences: %w", err)
+		}
+	}
+
+	return pb, nil
+}
+
+// ParticipantFromProto converts a protobuf participant back to the Go type
+func ParticipantFromProto(pb *Participant) (astra.Participant, error) {
+	var p astra.Participant
+	if pb == nil {
+		return p, fmt.Errorf("participant cannot be nil")
+	}
+
+	p.ID = pb.Id
+	p.Role = pb.Role
+	p.Name = pb.Name
+	p.Email = pb.Email
+	p.Phone = pb.Phone
+	p.ExternalID = pb.ExternalId
+	p.System = pb.System
+	p.Capabilities = pb.Capabilities
+	p.Permissions = pb.Permissions
+	p.Metadata = fromStruct(pb.Metadata)
+
+	var err error
+	if p.Type, err = fromEnum[astra.ParticipantType](ParticipantType_name, "PARTICIPANT_TYPE_", pb.Type); err != nil {
+		return p, err
+	}
+
+	if pb.Preferences != nil {
+		p.Preferences = &astra.ParticipantPreferences{
+			Language:              pb.Preferences.Language,
+			Timezone:              pb.Preferences.Timezone,
+			CommunicationChannels: pb.Preferences.CommunicationChannels,
+			AdditionalProperties:  fromStruct(pb.Preferences.AdditionalProperties),
+		}
+	}
+
+	return p, nil
+}
+
+// EntityRefToProto converts an entity reference, which may be a string ID or
+// a structured astra.Entity (by value or pointer)
+func EntityRefToProto(ref astra.EntityRef) (*EntityRef, error) {
+	switch r := ref.(type) {
+	case string:
+		return &EntityRef{Ref: &EntityRef_Id{Id: r}}, nil
+	case astra.Entity:
+		entity, err := entityToProto(r)
+		if err != nil {
+			return nil, err
+		}
+		return &EntityRef{Ref: &EntityRef_Entity{Entity: entity}}, nil
+	case *astra.Entity:
+		if r == nil {
+			return nil, fmt.Errorf("entity reference cannot be nil")
+		}
+		entity, err := entityToProto(*r)
+		if err != nil {
+			return nil, err
+		}
+		return &EntityRef{Ref: &EntityRef_Entity{Entity: entity}}, nil
+	default:
+		return nil, fmt.Errorf("invalid entity reference type: %T", ref)
+	}
+}
+
+// EntityRefFromProto converts an entity reference back to a string ID or an
+// astra.Entity value
+func EntityRefFromProto(pb *EntityRef) (astra.EntityRef, error) {
+	switch r := pb.GetRef().(type) {
+	case *EntityRef_Id:
+		return r.Id, nil
+	case *EntityRef_Entity:
+		return astra.Entity{
+			ID:         r.Entity.GetId(),
+			Type:       r.Entity.GetType(),
+			ExternalID: r.Entity.ExternalId,
+			System:     r.Entity.System,
+			Version:    r.Entity.Version,
+			SchemaURL:  r.Entity.SchemaUrl,
+			Metadata:   fromStruct(r.Entity.Metadata),
+		}, nil
+	default:
+		return nil, fmt.Errorf("entity reference has no id or entity set")
+	}
+}
+
+// entityToProto converts a structured entity
+func entityToProto(e astra.Entity) (*Entity, error) {
+	metadata, err := toStruct(e.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("entity metadata: %w", err)
+	}
+	return &Entity{
+		Id:         e.ID,
+		Type:       e.Type,
+		ExternalId: e.ExternalID,
+		System:     e.System,
+		Version:    e.Version,
+		SchemaUrl:  e.SchemaURL,
+		Metadata:   metadata,
+	}, nil
+}
+
+// ============================================================================
+// Value Helpers
+// ============================================================================
+
+// toValue converts any JSON-compatible Go value to a structpb.Value. Values
+// structpb cannot represent directly, such as structs or typed slices, are
+// converted through their JSON encoding.
+func toValue(v interface{}) (*structpb.Value, error) {
+	if value, err := structpb.NewValue(v); err == nil {
+		return value, nil
+	}
+	normalized, err := normalizeJSON(v)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(normalized)
+}
+
+// toStruct converts a map to a structpb.Struct, preserving the difference
+// between a nil map and an empty one
+func toStruct(m map[string]interface{}) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	if s, err := structpb.NewStruct(m); err == nil {
+		return s, nil
+	}
+	normalized, err := normalizeJSON(m)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := normalized.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %T", normalized)
+	}
+	return structpb.NewStruct(object)
+}
+
+// fromStruct converts a structpb.Struct back to a map, returning nil if unset
+func fromStruct(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// normalizeJSON round-trips a value through JSON so that it only contains the
+// types structpb understands
+func normalizeJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("value of type %T cannot be encoded: %w", v, err)
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// toInt32 converts an integral Go or JSON number to int32
+func toInt32(v interface{}) (int32, bool) {
+	var f float64
+	switch n := v.(type) {
+	case int:
+		f = float64(n)
+	case int32:
+		return n, true
+	case int64:
+		f = float64(n)
+	case float64:
+		f = n
+	default:
+		return 0, false
+	}
+	if f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(f), true
+}
+
+// toInt32Ptr converts an optional int, rejecting values outside the int32 range
+func toInt32Ptr(field string, v *int) (*int32, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if *v < math.MinInt32 || *v > math.MaxInt32 {
+		return nil, fmt.Errorf("%s %d overflows int32", field, *v)
+	}
+	n := int32(*v)
+	return &n, nil
+}
+
+// fromInt32Ptr converts an optional int32 back to an optional int
+func fromInt32Ptr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+// toStrings converts a list of strings, either typed or decoded from JSON
+func toStrings(v interface{}) ([]string, error) {
+	switch values := v.(type) {
+	case []string:
+		return values, nil
+	case []interface{}:
+		strs := make([]string, len(values))
+		for i, value := range values {
+			s, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("item %d must be a string, got %T", i, value)
+			}
+			strs[i] = s
+		}
+		return strs, nil
+	default:
+		return nil, fmt.Errorf("expected a list of strings, got %T", v)
+	}
+}
+
+// ============================================================================
+// Enum Helpers
+// ============================================================================
+
+// Go enums are lowercase strings ("speech_recognition") while proto enum values
+// are prefixed and uppercase ("SOURCE_SPEECH_RECOGNITION"). Value 0 is always
+// the UNSPECIFIED value.
+
+// toEnum converts a required Go enum value to its proto value
+func toEnum[E ~int32, T ~string](values map[string]int32, prefix string, value T) (E, error) {
+	n, ok := values[prefix+strings.ToUpper(string(value))]
+	if !ok || n == 0 {
+		return 0, fmt.Errorf("%s value %q cannot be represented in protobuf", strings.ToLower(strings.TrimSuffix(prefix, "_")), value)
+	}
+	return E(n), nil
+}
+
+// fromEnum converts a required proto enum value back to its Go value
+func fromEnum[T ~string, E ~int32](names map[int32]string, prefix string, value E) (T, error) {
+	name, ok := names[int32(value)]
+	if !ok || value == 0 {
+		return "", fmt.Errorf("%s value %d is not set or unknown", strings.ToLower(strings.TrimSuffix(prefix, "_")), value)
+	}
+	return T(strings.ToLower(strings.TrimPrefix(name, prefix))), nil
+}
+
+// toEnumPtr converts an optional Go enum to a proto3 optional enum
+func toEnumPtr[E ~int32, T ~string](values map[string]int32, prefix string, value *T) (*E, error) {
+	if value == nil {
+		return nil, nil
+	}
+	n, err := toEnum[E](values, prefix, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// fromEnumPtr converts a proto3 optional enum back to an optional Go enum
+func fromEnumPtr[T ~string, E ~int32](names map[int32]string, prefix string, value *E) (*T, error) {
+	if value == nil {
+		return nil, nil
+	}
+	v, err := fromEnum[T](names, prefix, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// toEnumOrUnspecified converts an optional Go enum to a plain proto enum,
+// mapping nil to UNSPECIFIED
+func toEnumOrUnspecified[E ~int32, T ~string](values map[string]int32, prefix string, value *T) (E, error) {
+	if value == nil {
+		return 0, nil
+	}
+	return toEnum[E](values, prefix, *value)
+}
+
+// fromEnumOrNil converts a plain proto enum back to an optional Go enum,
+// mapping UNSPECIFIED to nil
+func fromEnumOrNil[T ~string, E ~int32](names map[int32]string, prefix string, value E) (*T, error) {
+	if value == 0 {
+		return nil, nil
+	}
+	v, err := fromEnum[T](names, prefix, value)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
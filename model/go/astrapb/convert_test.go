@@ -0,0 +1,191 @@
+package astrapb
+
+import (
+	"testing"
+	"time"
+
+	astra "github.com/pryszm/astra-model-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newRoundTripConversation builds a conversation that exercises every act type
+// and optional field. Timestamps are UTC and free-form numbers are float64, the
+// forms they take after conversion back from protobuf.
+func newRoundTripConversation(t *testing.T) astra.Conversation {
+	t.Helper()
+
+	startedAt := time.Date(2025, time.January, 15, 14, 30, 0, 0, time.UTC)
+	at := func(seconds int) astra.ActOption {
+		return func(a *astra.Act) {
+			a.Timestamp = startedAt.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+
+	customer := astra.NewParticipant("customer_456", astra.ParticipantTypeHuman,
+		astra.WithRole("customer"), astra.WithName("Jane Doe"), astra.WithEmail("jane@example.com"))
+	customer.Preferences = &astra.ParticipantPreferences{
+		CommunicationChannels: []string{"voice", "sms"},
+		AdditionalProperties:  map[string]interface{}{"tone": "formal"},
+	}
+	agent := astra.NewParticipant("agent_123", astra.ParticipantTypeAI)
+	agent.Capabilities = []string{"order_management"}
+	agent.Metadata = map[string]interface{}{"model": "assistant", "temperature": 0.2}
+
+	order := astra.NewEntity("order_789", "order")
+	order.Metadata = map[string]interface{}{"items": []interface{}{"pizza", 2.0}}
+
+	ask := astra.NewAsk("agent_123", "email", "What's your email?",
+		// Builder constraints also remember which catalog message they carry,
+		// which the wire format has no field for, so messages are set explicitly
+		astra.WithConstraints([]astra.Constraint{
+			astra.NewConstraint(astra.ConstraintTypeRequired,
+				astra.WithConstraintMessage("This field is required")),
+			astra.NewConstraint(astra.ConstraintTypeMinLength, astra.WithConstraintValue(5),
+				astra.WithConstraintMessage("Minimum length is 5 characters"), astra.WithConstraintCode("too_short")),
+			astra.NewConstraint(astra.ConstraintTypeFormat, astra.WithConstraintValue(astra.FormatTypeEmail),
+				astra.WithConstraintMessage("Must be a valid email address")),
+			astra.NewConstraint(astra.ConstraintTypeEnum, astra.WithConstraintValue([]string{"a@example.com", "b@example.com"}),
+				astra.WithConstraintMessage("Must be one of: [a@example.com b@example.com]")),
+			astra.NewConstraint(astra.ConstraintTypePattern, astra.WithConstraintValue(`^\S+@\S+$`)),
+		}),
+		astra.WithRequired(true), astra.WithMaxRetries(3))
+	ask.Act = astra.CreateBaseAct("agent_123", astra.ActTypeAsk, at(1),
+		astra.WithConfidence(0.9), astra.WithSource(astra.SourceAI),
+		astra.WithMetadata(astra.ActMetadata{AdditionalProperties: map[string]interface{}{"turn": 1.0}}),
+		astra.WithChannel("voice"))
+
+	fact := astra.NewFact("customer_456", "order_789", "email", "jane@example.com",
+		astra.WithOperation(astra.FieldOperationSet), astra.WithPreviousValue(nil))
+	fact.Act = astra.CreateBaseAct("customer_456", astra.ActTypeFact, at(2),
+		astra.WithSource(astra.SourceSpeechRecognition))
+	status := astra.ValidationStatusValid
+	fact.ValidationStatus = &status
+
+	structured := astra.NewFact("customer_456", order, "address",
+		map[string]interface{}{"city": "Austin", "lines": []interface{}{"12 Main St"}},
+		astra.WithOperation(astra.FieldOperationMerge), astra.WithPreviousValue(map[string]interface{}{}))
+	structured.Act = astra.CreateBaseAct("customer_456", astra.ActTypeFact, at(3))
+
+	confirm := astra.NewConfirm("agent_123", order, "Deliver to 12 Main St?",
+		astra.WithAwaiting(false), astra.WithConfirmed(false),
+		astra.WithConfirmationMethod(astra.ConfirmationMethodVerbal))
+	confirm.Act = astra.CreateBaseAct("agent_123", astra.ActTypeConfirm, at(4))
+	confirm.FieldsConfirmed = []string{"address"}
+	reason := "wrong address"
+	confirm.RejectionReason = &reason
+
+	commitStatus := astra.CommitStatusFailed
+	commit := astra.NewCommit("system_001", "order_789", astra.CommitActionCreate)
+	commit.Act = astra.CreateBaseAct("system_001", astra.ActTypeCommit, at(5))
+	commit.Status = &commitStatus
+	commit.Error = &astra.CommitError{
+		Code:        "TIMEOUT",
+		Message:     "Order service timed out",
+		Details:     map[string]interface{}{"attempt": 1.0},
+		Recoverable: true,
+	}
+	retries := 0
+	commit.RetryCount = &retries
+	commit.RollbackInfo = map[string]interface{}{}
+
+	errorAct := astra.NewError("system_001", "ORDER_FAILED", "Order could not be placed", true,
+		astra.WithRelatedActID(commit.ID))
+	errorAct.Act = astra.CreateBaseAct("system_001", astra.ActTypeError, at(6))
+	severity := astra.ErrorSeverityWarning
+	category := astra.ErrorCategoryIntegration
+	action := astra.SuggestedActionRetry
+	errorAct.Severity = &severity
+	errorAct.Category = &category
+	errorAct.SuggestedAction = &action
+	errorAct.Details = map[string]interface{}{"service": "orders"}
+
+	conv := astra.NewConversation([]astra.Participant{agent, customer},
+		astra.WithConversationChannel("voice"), astra.WithTimeBudget(60000))
+	conv.StartedAt = &startedAt
+	conv.Context = &astra.ConversationContext{AdditionalProperties: map[string]interface{}{"campaign": "spring"}}
+	for _, act := range []astra.ConversationAct{ask, fact, structured, confirm, commit, errorAct} {
+		require.NoError(t, conv.AddAct(act))
+	}
+	require.NoError(t, conv.EndConversation(astra.ConversationStatusFailed))
+	endedAt := startedAt.Add(time.Minute)
+	conv.EndedAt = &endedAt
+	conv.FinalState = map[string]interface{}{"order_789": map[string]interface{}{"email": "jane@example.com"}}
+
+	return conv
+}
+
+func TestConversationProtoRoundTrip(t *testing.T) {
+	conv := newRoundTripConversation(t)
+
+	pb, err := ConversationToProto(conv)
+	require.NoError(t, err)
+
+	// Survive the wire as well as the in-memory conversion
+	data, err := proto.Marshal(pb)
+	require.NoError(t, err)
+	var decoded Conversation
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	roundTripped, err := ConversationFromProto(&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, conv, roundTripped)
+}
+
+func TestActProtoRoundTrip(t *testing.T) {
+	// Unset optional fields stay unset
+	ask := astra.NewAsk("agent_123", "email", "What's your email?")
+	ask.Timestamp = ask.Timestamp.UTC().Round(0)
+
+	pb, err := ActToProto(ask)
+	require.NoError(t, err)
+	assert.Nil(t, pb.GetAsk().Required)
+	assert.Nil(t, pb.GetAsk().GetAct().Source)
+
+	act, err := ActFromProto(pb)
+	require.NoError(t, err)
+	assert.Equal(t, ask, act)
+
+	// Pointer entities and typed values come back in their canonical form
+	order := astra.NewEntity("order_789", "order")
+	fact := astra.NewFact("customer_456", &order, "tags", []string{"gift"})
+	fact.Timestamp = fact.Timestamp.UTC().Round(0)
+
+	pb, err = ActToProto(fact)
+	require.NoError(t, err)
+	act, err = ActFromProto(pb)
+	require.NoError(t, err)
+	roundTripped, ok := act.(astra.Fact)
+	require.True(t, ok)
+	assert.Equal(t, order, roundTripped.Entity)
+	assert.Equal(t, []interface{}{"gift"}, roundTripped.Value)
+}
+
+func TestProtoConversionErrors(t *testing.T) {
+	ivr := astra.Source("ivr")
+	ask := astra.NewAsk("agent_123", "email", "What's your email?")
+	ask.Source = &ivr
+	_, err := ActToProto(ask)
+	assert.ErrorContains(t, err, "ivr")
+
+	fact := astra.NewFact("customer_456", 42, "email", "jane@example.com")
+	_, err = ActToProto(fact)
+	assert.ErrorContains(t, err, "invalid entity reference type")
+
+	custom := astra.NewConstraint(astra.ConstraintTypeCustom, astra.WithConstraintValue("luhn"))
+	_, err = ActToProto(astra.NewAsk("agent_123", "card", "Card number?",
+		astra.WithConstraints([]astra.Constraint{custom})))
+	assert.ErrorContains(t, err, "cannot be represented")
+
+	signed := astra.NewAsk("agent_123", "email", "What's your email?")
+	signed.Signature = &astra.ActSignature{Algorithm: astra.SignatureAlgorithmEd25519, Value: "c2lnbmF0dXJl"}
+	_, err = ActToProto(signed)
+	assert.ErrorContains(t, err, "signatures cannot be represented")
+
+	_, err = ActFromProto(&ConversationAct{})
+	assert.Error(t, err)
+
+	_, err = ConversationFromProto(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: error.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Severity level of the error
+type ErrorSeverity int32
+
+const (
+	ErrorSeverity_ERROR_SEVERITY_UNSPECIFIED ErrorSeverity = 0
+	ErrorSeverity_ERROR_SEVERITY_INFO        ErrorSeverity = 1
+	ErrorSeverity_ERROR_SEVERITY_WARNING     ErrorSeverity = 2
+	ErrorSeverity_ERROR_SEVERITY_ERROR       ErrorSeverity = 3
+	ErrorSeverity_ERROR_SEVERITY_CRITICAL    ErrorSeverity = 4
+)
+
+// Enum value maps for ErrorSeverity.
+var (
+	ErrorSeverity_name = map[int32]string{
+		0: "ERROR_SEVERITY_UNSPECIFIED",
+		1: "ERROR_SEVERITY_INFO",
+		2: "ERROR_SEVERITY_WARNING",
+		3: "ERROR_SEVERITY_ERROR",
+		4: "ERROR_SEVERITY_CRITICAL",
+	}
+	ErrorSeverity_value = map[string]int32{
+		"ERROR_SEVERITY_UNSPECIFIED": 0,
+		"ERROR_SEVERITY_INFO":        1,
+		"ERROR_SEVERITY_WARNING":     2,
+		"ERROR_SEVERITY_ERROR":       3,
+		"ERROR_SEVERITY_CRITICAL":    4,
+	}
+)
+
+func (x ErrorSeverity) Enum() *ErrorSeverity {
+	p := new(ErrorSeverity)
+	*p = x
+	return p
+}
+
+func (x ErrorSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_error_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorSeverity) Type() protoreflect.EnumType {
+	return &file_error_proto_enumTypes[0]
+}
+
+func (x ErrorSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorSeverity.Descriptor instead.
+func (ErrorSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{0}
+}
+
+// Category of error for classification
+type ErrorCategory int32
+
+const (
+	ErrorCategory_ERROR_CATEGORY_UNSPECIFIED   ErrorCategory = 0
+	ErrorCategory_ERROR_CATEGORY_VALIDATION    ErrorCategory = 1
+	ErrorCategory_ERROR_CATEGORY_PROCESSING    ErrorCategory = 2
+	ErrorCategory_ERROR_CATEGORY_INTEGRATION   ErrorCategory = 3
+	ErrorCategory_ERROR_CATEGORY_TIMEOUT       ErrorCategory = 4
+	ErrorCategory_ERROR_CATEGORY_PERMISSION    ErrorCategory = 5
+	ErrorCategory_ERROR_CATEGORY_SYSTEM        ErrorCategory = 6
+	ErrorCategory_ERROR_CATEGORY_USER_INPUT    ErrorCategory = 7
+	ErrorCategory_ERROR_CATEGORY_BUSINESS_RULE ErrorCategory = 8
+)
+
+// Enum value maps for ErrorCategory.
+var (
+	ErrorCategory_name = map[int32]string{
+		0: "ERROR_CATEGORY_UNSPECIFIED",
+		1: "ERROR_CATEGORY_VALIDATION",
+		2: "ERROR_CATEGORY_PROCESSING",
+		3: "ERROR_CATEGORY_INTEGRATION",
+		4: "ERROR_CATEGORY_TIMEOUT",
+		5: "ERROR_CATEGORY_PERMISSION",
+		6: "ERROR_CATEGORY_SYSTEM",
+		7: "ERROR_CATEGORY_USER_INPUT",
+		8: "ERROR_CATEGORY_BUSINESS_RULE",
+	}
+	ErrorCategory_value = map[string]int32{
+		"ERROR_CATEGORY_UNSPECIFIED":   0,
+		"ERROR_CATEGORY_VALIDATION":    1,
+		"ERROR_CATEGORY_PROCESSING":    2,
+		"ERROR_CATEGORY_INTEGRATION":   3,
+		"ERROR_CATEGORY_TIMEOUT":       4,
+		"ERROR_CATEGORY_PERMISSION":    5,
+		"ERROR_CATEGORY_SYSTEM":        6,
+		"ERROR_CATEGORY_USER_INPUT":    7,
+		"ERROR_CATEGORY_BUSINESS_RULE": 8,
+	}
+)
+
+func (x ErrorCategory) Enum() *ErrorCategory {
+	p := new(ErrorCategory)
+	*p = x
+	return p
+}
+
+func (x ErrorCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_error_proto_enumTypes[1].Descriptor()
+}
+
+func (ErrorCategory) Type() protoreflect.EnumType {
+	return &file_error_proto_enumTypes[1]
+}
+
+func (x ErrorCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCategory.Descriptor instead.
+func (ErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{1}
+}
+
+// Suggested recovery action
+type SuggestedAction int32
+
+const (
+	SuggestedAction_SUGGESTED_ACTION_UNSPECIFIED SuggestedAction = 0
+	SuggestedAction_SUGGESTED_ACTION_RETRY       SuggestedAction = 1
+	SuggestedAction_SUGGESTED_ACTION_ESCALATE    SuggestedAction = 2
+	SuggestedAction_SUGGESTED_ACTION_IGNORE      SuggestedAction = 3
+	SuggestedAction_SUGGESTED_ACTION_CLARIFY     SuggestedAction = 4
+	SuggestedAction_SUGGESTED_ACTION_FALLBACK    SuggestedAction = 5
+	SuggestedAction_SUGGESTED_ACTION_TERMINATE   SuggestedAction = 6
+)
+
+// Enum value maps for SuggestedAction.
+var (
+	SuggestedAction_name = map[int32]string{
+		0: "SUGGESTED_ACTION_UNSPECIFIED",
+		1: "SUGGESTED_ACTION_RETRY",
+		2: "SUGGESTED_ACTION_ESCALATE",
+		3: "SUGGESTED_ACTION_IGNORE",
+		4: "SUGGESTED_ACTION_CLARIFY",
+		5: "SUGGESTED_ACTION_FALLBACK",
+		6: "SUGGESTED_ACTION_TERMINATE",
+	}
+	SuggestedAction_value = map[string]int32{
+		"SUGGESTED_ACTION_UNSPECIFIED": 0,
+		"SUGGESTED_ACTION_RETRY":       1,
+		"SUGGESTED_ACTION_ESCALATE":    2,
+		"SUGGESTED_ACTION_IGNORE":      3,
+		"SUGGESTED_ACTION_CLARIFY":     4,
+		"SUGGESTED_ACTION_FALLBACK":    5,
+		"SUGGESTED_ACTION_TERMINATE":   6,
+	}
+)
+
+func (x SuggestedAction) Enum() *SuggestedAction {
+	p := new(SuggestedAction)
+	*p = x
+	return p
+}
+
+func (x SuggestedAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestedAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_error_proto_enumTypes[2].Descriptor()
+}
+
+func (SuggestedAction) Type() protoreflect.EnumType {
+	return &file_error_proto_enumTypes[2]
+}
+
+func (x SuggestedAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestedAction.Descriptor instead.
+func (SuggestedAction) EnumDescriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{2}
+}
+
+// Act that handles failures and exceptions in conversational processing
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base act properties
+	Act *Act `protobuf:"bytes,1,opt,name=act,proto3" json:"act,omitempty"`
+	// Machine-readable error code
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable error message
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether the conversation can continue after this error
+	Recoverable bool `protobuf:"varint,4,opt,name=recoverable,proto3" json:"recoverable,omitempty"`
+	// Severity level of the error
+	Severity ErrorSeverity `protobuf:"varint,5,opt,name=severity,proto3,enum=astra.v1.ErrorSeverity" json:"severity,omitempty"` // defaults to ERROR
+	// Category of error for classification
+	Category *ErrorCategory `protobuf:"varint,6,opt,name=category,proto3,enum=astra.v1.ErrorCategory,oneof" json:"category,omitempty"`
+	// Additional error context and debugging information
+	Details *structpb.Struct `protobuf:"bytes,7,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	// ID of the act that caused this error
+	RelatedActId *string `protobuf:"bytes,8,opt,name=related_act_id,json=relatedActId,proto3,oneof" json:"related_act_id,omitempty"`
+	// Suggested recovery action
+	SuggestedAction *SuggestedAction `protobuf:"varint,9,opt,name=suggested_action,json=suggestedAction,proto3,enum=astra.v1.SuggestedAction,oneof" json:"suggested_action,omitempty"`
+	// User-friendly message to display to conversation participants
+	UserMessage *string `protobuf:"bytes,10,opt,name=user_message,json=userMessage,proto3,oneof" json:"user_message,omitempty"`
+	// Technical stack trace for debugging (not shown to users)
+	StackTrace *string `protobuf:"bytes,11,opt,name=stack_trace,json=stackTrace,proto3,oneof" json:"stack_trace,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_error_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_error_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Error) GetAct() *Act {
+	if x != nil {
+		return x.Act
+	}
+	return nil
+}
+
+func (x *Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Error) GetRecoverable() bool {
+	if x != nil {
+		return x.Recoverable
+	}
+	return false
+}
+
+func (x *Error) GetSeverity() ErrorSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ErrorSeverity_ERROR_SEVERITY_UNSPECIFIED
+}
+
+func (x *Error) GetCategory() ErrorCategory {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ErrorCategory_ERROR_CATEGORY_UNSPECIFIED
+}
+
+func (x *Error) GetDetails() *structpb.Struct {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Error) GetRelatedActId() string {
+	if x != nil && x.RelatedActId != nil {
+		return *x.RelatedActId
+	}
+	return ""
+}
+
+func (x *Error) GetSuggestedAction() SuggestedAction {
+	if x != nil && x.SuggestedAction != nil {
+		return *x.SuggestedAction
+	}
+	return SuggestedAction_SUGGESTED_ACTION_UNSPECIFIED
+}
+
+func (x *Error) GetUserMessage() string {
+	if x != nil && x.UserMessage != nil {
+		return *x.UserMessage
+	}
+	return ""
+}
+
+func (x *Error) GetStackTrace() string {
+	if x != nil && x.StackTrace != nil {
+		return *x.StackTrace
+	}
+	return ""
+}
+
+var File_error_proto protoreflect.FileDescriptor
+
+var file_error_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc5, 0x04, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x48, 0x01, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a,
+	0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2a, 0x9b, 0x01, 0x0a, 0x0d, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x46,
+	0x4f, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54,
+	0x49, 0x43, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0xa4, 0x02, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
+	0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10,
+	0x05, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x42, 0x55,
+	0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x08, 0x2a, 0xe8, 0x01,
+	0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x45, 0x44,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x53,
+	0x55, 0x47, 0x47, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4c, 0x41, 0x52, 0x49, 0x46, 0x59, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x55, 0x47,
+	0x47, 0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x55, 0x47, 0x47,
+	0x45, 0x53, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x52,
+	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x06, 0x42, 0x5d, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2f, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x41,
+	0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_error_proto_rawDescOnce sync.Once
+	file_error_proto_rawDescData = file_error_proto_rawDesc
+)
+
+func file_error_proto_rawDescGZIP() []byte {
+	file_error_proto_rawDescOnce.Do(func() {
+		file_error_proto_rawDescData = protoimpl.X.CompressGZIP(file_error_proto_rawDescData)
+	})
+	return file_error_proto_rawDescData
+}
+
+var file_error_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_error_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_error_proto_goTypes = []any{
+	(ErrorSeverity)(0),      // 0: astra.v1.ErrorSeverity
+	(ErrorCategory)(0),      // 1: astra.v1.ErrorCategory
+	(SuggestedAction)(0),    // 2: astra.v1.SuggestedAction
+	(*Error)(nil),           // 3: astra.v1.Error
+	(*Act)(nil),             // 4: astra.v1.Act
+	(*structpb.Struct)(nil), // 5: google.protobuf.Struct
+}
+var file_error_proto_depIdxs = []int32{
+	4, // 0: astra.v1.Error.act:type_name -> astra.v1.Act
+	0, // 1: astra.v1.Error.severity:type_name -> astra.v1.ErrorSeverity
+	1, // 2: astra.v1.Error.category:type_name -> astra.v1.ErrorCategory
+	5, // 3: astra.v1.Error.details:type_name -> google.protobuf.Struct
+	2, // 4: astra.v1.Error.suggested_action:type_name -> astra.v1.SuggestedAction
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_error_proto_init() }
+func file_error_proto_init() {
+	if File_error_proto != nil {
+		return
+	}
+	file_act_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_error_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_error_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_error_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_error_proto_goTypes,
+		DependencyIndexes: file_error_proto_depIdxs,
+		EnumInfos:         file_error_proto_enumTypes,
+		MessageInfos:      file_error_proto_msgTypes,
+	}.Build()
+	File_error_proto = out.File
+	file_error_proto_rawDesc = nil
+	file_error_proto_goTypes = nil
+	file_error_proto_depIdxs = nil
+}
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: fact.proto
+
+package astrapb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Operation being performed on the field
+type FieldOperation int32
+
+const (
+	FieldOperation_FIELD_OPERATION_UNSPECIFIED FieldOperation = 0
+	FieldOperation_FIELD_OPERATION_SET         FieldOperation = 1
+	FieldOperation_FIELD_OPERATION_APPEND      FieldOperation = 2
+	FieldOperation_FIELD_OPERATION_INCREMENT   FieldOperation = 3
+	FieldOperation_FIELD_OPERATION_DECREMENT   FieldOperation = 4
+	FieldOperation_FIELD_OPERATION_DELETE      FieldOperation = 5
+	FieldOperation_FIELD_OPERATION_MERGE       FieldOperation = 6
+)
+
+// Enum value maps for FieldOperation.
+var (
+	FieldOperation_name = map[int32]string{
+		0: "FIELD_OPERATION_UNSPECIFIED",
+		1: "FIELD_OPERATION_SET",
+		2: "FIELD_OPERATION_APPEND",
+		3: "FIELD_OPERATION_INCREMENT",
+		4: "FIELD_OPERATION_DECREMENT",
+		5: "FIELD_OPERATION_DELETE",
+		6: "FIELD_OPERATION_MERGE",
+	}
+	FieldOperation_value = map[string]int32{
+		"FIELD_OPERATION_UNSPECIFIED": 0,
+		"FIELD_OPERATION_SET":         1,
+		"FIELD_OPERATION_APPEND":      2,
+		"FIELD_OPERATION_INCREMENT":   3,
+		"FIELD_OPERATION_DECREMENT":   4,
+		"FIELD_OPERATION_DELETE":      5,
+		"FIELD_OPERATION_MERGE":       6,
+	}
+)
+
+func (x FieldOperation) Enum() *FieldOperation {
+	p := new(FieldOperation)
+	*p = x
+	return p
+}
+
+func (x FieldOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_fact_proto_enumTypes[0].Descriptor()
+}
+
+func (FieldOperation) Type() protoreflect.EnumType {
+	return &file_fact_proto_enumTypes[0]
+}
+
+func (x FieldOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldOperation.Descriptor instead.
+func (FieldOperation) EnumDescriptor() ([]byte, []int) {
+	return file_fact_proto_rawDescGZIP(), []int{0}
+}
+
+// Validation status of this fact
+type ValidationStatus int32
+
+const (
+	ValidationStatus_VALIDATION_STATUS_UNSPECIFIED ValidationStatus = 0
+	ValidationStatus_VALIDATION_STATUS_PENDING     ValidationStatus = 1
+	ValidationStatus_VALIDATION_STATUS_VALID       ValidationStatus = 2
+	ValidationStatus_VALIDATION_STATUS_INVALID     ValidationStatus = 3
+	ValidationStatus_VALIDATION_STATUS_PARTIAL     ValidationStatus = 4
+)
+
+// Enum value maps for ValidationStatus.
+var (
+	ValidationStatus_name = map[int32]string{
+		0: "VALIDATION_STATUS_UNSPECIFIED",
+		1: "VALIDATION_STATUS_PENDING",
+		2: "VALIDATION_STATUS_VALID",
+		3: "VALIDATION_STATUS_INVALID",
+		4: "VALIDATION_STATUS_PARTIAL",
+	}
+	ValidationStatus_value = map[string]int32{
+		"VALIDATION_STATUS_UNSPECIFIED": 0,
+		"VALIDATION_STATUS_PENDING":     1,
+		"VALIDATION_STATUS_VALID":       2,
+		"VALIDATION_STATUS_INVALID":     3,
+		"VALIDATION_STATUS_PARTIAL":     4,
+	}
+)
+
+func (x ValidationStatus) Enum() *ValidationStatus {
+	p := new(ValidationStatus)
+	*p = x
+	return p
+}
+
+func (x ValidationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_fact_proto_enumTypes[1].Descriptor()
+}
+
+func (ValidationStatus) Type() protoreflect.EnumType {
+	return &file_fact_proto_enumTypes[1]
+}
+
+func (x ValidationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationStatus.Descriptor instead.
+func (ValidationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_fact_proto_rawDescGZIP(), []int{1}
+}
+
+// Act that declares facts or information provided during conversation
+type Fact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base act properties
+	Act *Act `protobuf:"bytes,1,opt,name=act,proto3" json:"act,omitempty"`
+	// Business entity being modified (order, customer, appointment, etc.)
+	Entity *EntityRef `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Specific field or property being set
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// Value being assigned to the field (encoded as a Value to support any JSON type)
+	Value *structpb.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Operation being performed on the field
+	Operation FieldOperation `protobuf:"varint,5,opt,name=operation,proto3,enum=astra.v1.FieldOperation" json:"operation,omitempty"` // defaults to SET
+	// Previous value of the field (for audit trail)
+	PreviousValue *structpb.Value `protobuf:"bytes,6,opt,name=previous_value,json=previousValue,proto3,oneof" json:"previous_value,omitempty"`
+	// Validation status of this fact
+	ValidationStatus ValidationStatus `protobuf:"varint,7,opt,name=validation_status,json=validationStatus,proto3,enum=astra.v1.ValidationStatus" json:"validation_status,omitempty"` // defaults to PENDING
+	// List of validation errors if validation_status is invalid
+	ValidationErrors []string `protobuf:"bytes,8,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+}
+
+func (x *Fact) Reset() {
+	*x = Fact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fact_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fact) ProtoMessage() {}
+
+func (x *Fact) ProtoReflect() protoreflect.Message {
+	mi := &file_fact_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fact.ProtoReflect.Descriptor instead.
+func (*Fact) Descriptor() ([]byte, []int) {
+	return file_fact_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Fact) GetAct() *Act {
+	if x != nil {
+		return x.Act
+	}
+	return nil
+}
+
+func (x *Fact) GetEntity() *EntityRef {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *Fact) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Fact) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Fact) GetOperation() FieldOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return FieldOperation_FIELD_OPERATION_UNSPECIFIED
+}
+
+func (x *Fact) GetPreviousValue() *structpb.Value {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return nil
+}
+
+func (x *Fact) GetValidationStatus() ValidationStatus {
+	if x != nil {
+		return x.ValidationStatus
+	}
+	return ValidationStatus_VALIDATION_STATUS_UNSPECIFIED
+}
+
+func (x *Fact) GetValidationErrors() []string {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+var File_fact_proto protoreflect.FileDescriptor
+
+var file_fact_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x66, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x03,
+	0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xdb, 0x01,
+	0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50,
+	0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05,
+	0x12, 0x19, 0x0a, 0x15, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x06, 0x2a, 0xaf, 0x01, 0x0a, 0x10,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x12,
+	0x1d, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x04, 0x42, 0x5c, 0x0a,
+	0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x79, 0x73, 0x7a, 0x6d, 0x2e, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x46, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x7a, 0x6d, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x67,
+	0x6f, 0x2f, 0x61, 0x73, 0x74, 0x72, 0x61, 0x70, 0x62, 0xaa, 0x02, 0x0f, 0x50, 0x72, 0x79, 0x73,
+	0x7a, 0x6d, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x61, 0x2e, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fact_proto_rawDescOnce sync.Once
+	file_fact_proto_rawDescData = file_fact_proto_rawDesc
+)
+
+func file_fact_proto_rawDescGZIP() []byte {
+	file_fact_proto_rawDescOnce.Do(func() {
+		file_fact_proto_rawDescData = protoimpl.X.CompressGZIP(file_fact_proto_rawDescData)
+	})
+	return file_fact_proto_rawDescData
+}
+
+var file_fact_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fact_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fact_proto_goTypes = []any{
+	(FieldOperation)(0),    // 0: astra.v1.FieldOperation
+	(ValidationStatus)(0),  // 1: astra.v1.ValidationStatus
+	(*Fact)(nil),           // 2: astra.v1.Fact
+	(*Act)(nil),            // 3: astra.v1.Act
+	(*EntityRef)(nil),      // 4: astra.v1.EntityRef
+	(*structpb.Value)(nil), // 5: google.protobuf.Value
+}
+var file_fact_proto_depIdxs = []int32{
+	3, // 0: astra.v1.Fact.act:type_name -> astra.v1.Act
+	4, // 1: astra.v1.Fact.entity:type_name -> astra.v1.EntityRef
+	5, // 2: astra.v1.Fact.value:type_name -> google.protobuf.Value
+	0, // 3: astra.v1.Fact.operation:type_name -> astra.v1.FieldOperation
+	5, // 4: astra.v1.Fact.previous_value:type_name -> google.protobuf.Value
+	1, // 5: astra.v1.Fact.validation_status:type_name -> astra.v1.ValidationStatus
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_fact_proto_init() }
+func file_fact_proto_init() {
+	if File_fact_proto != nil {
+		return
+	}
+	file_act_proto_init()
+	file_entity_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fact_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Fact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_fact_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fact_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fact_proto_goTypes,
+		DependencyIndexes: file_fact_proto_depIdxs,
+		EnumInfos:         file_fact_proto_enumTypes,
+		MessageInfos:      file_fact_proto_msgTypes,
+	}.Build()
+	File_fact_proto = out.File
+	file_fact_proto_rawDesc = nil
+	file_fact_proto_goTypes = nil
+	file_fact_proto_depIdxs = nil
+}
@@ -0,0 +1,111 @@
+package astra
+
+// ============================================================================
+// Incremental Builders
+// ============================================================================
+
+// AskBuilder accumulates an Ask whose parts arrive over time, as when a
+// streaming speech pipeline recognizes the requested field before the prompt
+// is complete. The act's ID and timestamp are assigned when the builder is
+// created, so they reflect when the act began rather than when it was
+// finalized. An AskBuilder is not safe for concurrent use.
+type AskBuilder struct {
+	ask Ask
+}
+
+// NewAskBuilder starts an Ask spoken by speaker. Field and prompt are set as
+// they become known.
+func NewAskBuilder(speaker string, options ...AskOption) *AskBuilder {
+	return &AskBuilder{ask: NewAsk(speaker, "", "", options...)}
+}
+
+// SetField sets the field being requested
+func (b *AskBuilder) SetField(field string) *AskBuilder {
+	b.ask.Field = field
+	return b
+}
+
+// SetPrompt sets the question presented to obtain the field
+func (b *AskBuilder) SetPrompt(prompt string) *AskBuilder {
+	b.ask.Prompt = prompt
+	return b
+}
+
+// Apply applies options to the ask being built
+func (b *AskBuilder) Apply(options ...AskOption) *AskBuilder {
+	for _, option := range options {
+		option(&b.ask)
+	}
+	return b
+}
+
+// IsComplete reports whether the field and prompt required by an Ask are set
+func (b *AskBuilder) IsComplete() bool {
+	return b.ask.Field != "" && b.ask.Prompt != ""
+}
+
+// Build validates the accumulated ask with ValidateAct and returns a copy of
+// it. The builder is left as it was, so a failed Build can be retried once
+// more parts arrive.
+func (b *AskBuilder) Build() (Ask, error) {
+	if err := ValidateAct(b.ask); err != nil {
+		return Ask{}, err
+	}
+	return CloneAct(b.ask).(Ask), nil
+}
+
+// FactBuilder accumulates a Fact whose parts arrive over time, typically the
+// field before its value. Like AskBuilder, the act's ID and timestamp are
+// assigned when the builder is created. A FactBuilder is not safe for
+// concurrent use.
+type FactBuilder struct {
+	fact Fact
+}
+
+// NewFactBuilder starts a Fact spoken by speaker. Entity, field, and value are
+// set as they become known.
+func NewFactBuilder(speaker string, options ...FactOption) *FactBuilder {
+	return &FactBuilder{fact: NewFact(speaker, nil, "", nil, options...)}
+}
+
+// SetEntity sets the entity the fact applies to
+func (b *FactBuilder) SetEntity(entity EntityRef) *FactBuilder {
+	b.fact.Entity = entity
+	return b
+}
+
+// SetField sets the field being set
+func (b *FactBuilder) SetField(field string) *FactBuilder {
+	b.fact.Field = field
+	return b
+}
+
+// SetValue sets the value assigned to the field
+func (b *FactBuilder) SetValue(value interface{}) *FactBuilder {
+	b.fact.Value = value
+	return b
+}
+
+// Apply applies options to the fact being built
+func (b *FactBuilder) Apply(options ...FactOption) *FactBuilder {
+	for _, option := range options {
+		option(&b.fact)
+	}
+	return b
+}
+
+// IsComplete reports whether the entity, field, and value required by a Fact
+// are set
+func (b *FactBuilder) IsComplete() bool {
+	return b.fact.Entity != nil && b.fact.Field != "" && b.fact.Value != nil
+}
+
+// Build validates the accumulated fact with ValidateAct and returns a copy of
+// it. The builder is left as it was, so a failed Build can be retried once
+// more parts arrive.
+func (b *FactBuilder) Build() (Fact, error) {
+	if err := ValidateAct(b.fact); err != nil {
+		return Fact{}, err
+	}
+	return CloneAct(b.fact).(Fact), nil
+}
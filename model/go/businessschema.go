@@ -0,0 +1,191 @@
+package astra
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// ============================================================================
+// Business Schemas
+// ============================================================================
+
+// BusinessSchema describes what a conversation following a business schema,
+// as named by Conversation.Schema, may contain
+type BusinessSchema struct {
+	// Identifier matched against Conversation.Schema (e.g. customer_service_v1)
+	ID string
+	// Fields that asks and facts may refer to, by field name. When empty, any
+	// field is allowed.
+	Fields map[string]FieldDefinition
+	// Fields that every conversation must ask for
+	RequiredAsks []string
+	// Entity types that facts, confirms and commits may refer to. When empty,
+	// any entity type is allowed.
+	EntityTypes []string
+}
+
+// FieldDefinition describes the values a business schema allows for a field
+type FieldDefinition struct {
+	// Type the value must coerce to with CoerceValue, if set
+	Type *ExpectedType
+	// Constraints the value must satisfy
+	Constraints []Constraint
+}
+
+// SchemaRegistry holds business schemas by ID. It is safe for concurrent use.
+type SchemaRegistry struct {
+	mu      sync.RWMutex
+	schemas map[string]BusinessSchema
+}
+
+// NewSchemaRegistry creates an empty SchemaRegistry
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{schemas: make(map[string]BusinessSchema)}
+}
+
+// Register adds a business schema to the registry, replacing any schema
+// previously registered with the same ID
+func (r *SchemaRegistry) Register(schema BusinessSchema) error {
+	if schema.ID == "" {
+		return ValidationError{Field: "id", Message: "business schema ID is required", Value: schema.ID, Err: ErrMissingField}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schemas[schema.ID] = schema
+	return nil
+}
+
+// Lookup returns the business schema registered with the given ID, or a
+// SchemaNotRegisteredError
+func (r *SchemaRegistry) Lookup(id string) (BusinessSchema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schema, ok := r.schemas[id]
+	if !ok {
+		return BusinessSchema{}, SchemaNotRegisteredError{Schema: id}
+	}
+	return schema, nil
+}
+
+// Schemas returns the IDs of the registered business schemas, sorted
+func (r *SchemaRegistry) Schemas() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.schemas))
+	for id := range r.schemas {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ValidateConversation checks a conversation against the business schema its
+// Schema field names and returns every problem found:
+//   - asks and facts for fields the schema does not define
+//   - fact values that do not coerce to the field's type or that violate its
+//     constraints (values of delete operations are not checked)
+//   - facts, confirms and commits on entities of types the schema does not
+//     allow (bare entity IDs carry no type and are not checked)
+//   - required asks that the conversation never makes
+//
+// A conversation without a schema, or whose schema is not registered, yields
+// a single error.
+func (r *SchemaRegistry) ValidateConversation(c Conversation) []error {
+	if c.Schema == nil || *c.Schema == "" {
+		return []error{ValidationError{Field: "schema", Message: "conversation does not name a business schema", Value: c.Schema, Err: ErrMissingField}}
+	}
+	schema, err := r.Lookup(*c.Schema)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	asked := make(map[string]struct{})
+	for i, act := range c.Acts {
+		switch a := act.(type) {
+		case Ask:
+			asked[a.Field] = struct{}{}
+			if !schema.definesField(a.Field) {
+				errs = append(errs, fmt.Errorf("act %d: field %s is not defined by schema %s", i, a.Field, schema.ID))
+			}
+		case Fact:
+			for _, err := range schema.validateFact(a) {
+				errs = append(errs, fmt.Errorf("act %d: %w", i, err))
+			}
+		}
+
+		if err := schema.validateEntityType(act); err != nil {
+			errs = append(errs, fmt.Errorf("act %d: %w", i, err))
+		}
+	}
+
+	for _, field := range schema.RequiredAsks {
+		if _, ok := asked[field]; !ok {
+			errs = append(errs, fmt.Errorf("required ask for field %s is missing", field))
+		}
+	}
+
+	return errs
+}
+
+// definesField reports whether the schema allows a field
+func (s BusinessSchema) definesField(field string) bool {
+	if len(s.Fields) == 0 {
+		return true
+	}
+	_, ok := s.Fields[field]
+	return ok
+}
+
+// validateFact checks a fact's field and value against the schema
+func (s BusinessSchema) validateFact(fact Fact) []error {
+	if !s.definesField(fact.Field) {
+		return []error{fmt.Errorf("field %s is not defined by schema %s", fact.Field, s.ID)}
+	}
+	if fact.Operation != nil && *fact.Operation == FieldOperationDelete {
+		return nil
+	}
+
+	definition := s.Fields[fact.Field]
+	if definition.Type != nil {
+		if _, err := CoerceValue(fact.Value, *definition.Type); err != nil {
+			return []error{fmt.Errorf("field %s: %w", fact.Field, err)}
+		}
+	}
+
+	var errs []error
+	for _, violation := range EvaluateConstraints(fact.Value, definition.Constraints) {
+		errs = append(errs, fmt.Errorf("field %s: %w", fact.Field, violation))
+	}
+	return errs
+}
+
+// validateEntityType checks the type of the entity an act refers to, if any
+func (s BusinessSchema) validateEntityType(act ConversationAct) error {
+	if len(s.EntityTypes) == 0 {
+		return nil
+	}
+
+	var ref EntityRef
+	switch a := act.(type) {
+	case Fact:
+		ref = a.Entity
+	case Confirm:
+		ref = a.Entity
+	case Commit:
+		ref = a.Entity
+	default:
+		return nil
+	}
+
+	entity, err := NormalizeEntityRef(ref)
+	if err != nil {
+		return err
+	}
+	if entity.Type == "" || slices.Contains(s.EntityTypes, entity.Type) {
+		return nil
+	}
+	return fmt.Errorf("entity %s has type %s, which schema %s does not allow", entity.ID, entity.Type, s.ID)
+}
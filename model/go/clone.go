@@ -0,0 +1,256 @@
+package astra
+
+// ============================================================================
+// Deep Copy Utilities
+// ============================================================================
+
+// Clone returns a deep copy of the conversation. Acts, participants, and all
+// pointer, slice, and map fields are copied so the clone shares no mutable
+// state with the original.
+func (c Conversation) Clone() Conversation {
+	clone := c
+	clone.StartedAt = clonePtr(c.StartedAt)
+	clone.EndedAt = clonePtr(c.EndedAt)
+	clone.Status = clonePtr(c.Status)
+	clone.SchemaVersion = clonePtr(c.SchemaVersion)
+	clone.Channel = clonePtr(c.Channel)
+	clone.Schema = clonePtr(c.Schema)
+	clone.TimeBudgetMs = clonePtr(c.TimeBudgetMs)
+	clone.FinalState = cloneMap(c.FinalState)
+	clone.hooks = append([]ActHook(nil), c.hooks...)
+
+	if c.Participants != nil {
+		clone.Participants = make([]Participant, len(c.Participants))
+		for i, participant := range c.Participants {
+			clone.Participants[i] = cloneParticipant(participant)
+		}
+	}
+
+	if c.Acts != nil {
+		clone.Acts = make([]ConversationAct, len(c.Acts))
+		for i, act := range c.Acts {
+			clone.Acts[i] = CloneAct(act)
+		}
+	}
+
+	if c.Context != nil {
+		context := *c.Context
+		context.SessionID = clonePtr(c.Context.SessionID)
+		context.UserAgent = clonePtr(c.Context.UserAgent)
+		context.IPAddress = clonePtr(c.Context.IPAddress)
+		context.Referrer = clonePtr(c.Context.Referrer)
+		context.AdditionalProperties = cloneMap(c.Context.AdditionalProperties)
+		clone.Context = &context
+	}
+
+	if c.Metadata != nil {
+		metadata := *c.Metadata
+		metadata.TotalDurationMs = clonePtr(c.Metadata.TotalDurationMs)
+		metadata.ActCount = clonePtr(c.Metadata.ActCount)
+		metadata.ErrorCount = clonePtr(c.Metadata.ErrorCount)
+		metadata.CommitCount = clonePtr(c.Metadata.CommitCount)
+		metadata.AvgConfidence = clonePtr(c.Metadata.AvgConfidence)
+		metadata.AdditionalProperties = cloneMap(c.Metadata.AdditionalProperties)
+		clone.Metadata = &metadata
+	}
+
+	return clone
+}
+
+// CloneAct returns a deep copy of an act of any of the five act types, sharing
+// no pointer, slice, or map fields with the original. Acts of other types,
+// which the package cannot see inside, are returned as they are.
+func CloneAct(act ConversationAct) ConversationAct {
+	switch a := act.(type) {
+	case Ask:
+		a.Act = cloneBaseAct(a.Act)
+		a.Constraints = cloneConstraints(a.Constraints)
+		a.Required = clonePtr(a.Required)
+		a.ExpectedType = clonePtr(a.ExpectedType)
+		a.RetryCount = clonePtr(a.RetryCount)
+		a.MaxRetries = clonePtr(a.MaxRetries)
+		return a
+	case Fact:
+		a.Act = cloneBaseAct(a.Act)
+		a.Entity = cloneEntityRef(a.Entity)
+		a.Value = cloneValue(a.Value)
+		a.Operation = clonePtr(a.Operation)
+		a.PreviousValue = cloneValue(a.PreviousValue)
+		a.ValidationStatus = clonePtr(a.ValidationStatus)
+		a.ValidationErrors = cloneStrings(a.ValidationErrors)
+		return a
+	case Confirm:
+		a.Act = cloneBaseAct(a.Act)
+		a.Entity = cloneEntityRef(a.Entity)
+		a.Awaiting = clonePtr(a.Awaiting)
+		a.Confirmed = clonePtr(a.Confirmed)
+		a.ConfirmationMethod = clonePtr(a.ConfirmationMethod)
+		a.FieldsConfirmed = cloneStrings(a.FieldsConfirmed)
+		a.RejectionReason = clonePtr(a.RejectionReason)
+		a.TimeoutMs = clonePtr(a.TimeoutMs)
+		return a
+	case Commit:
+		a.Act = cloneBaseAct(a.Act)
+		a.Entity = cloneEntityRef(a.Entity)
+		a.System = clonePtr(a.System)
+		a.TransactionID = clonePtr(a.TransactionID)
+		a.Status = clonePtr(a.Status)
+		if a.Error != nil {
+			commitError := *a.Error
+			commitError.Details = cloneMap(a.Error.Details)
+			a.Error = &commitError
+		}
+		a.RetryCount = clonePtr(a.RetryCount)
+		a.MaxRetries = clonePtr(a.MaxRetries)
+		a.IdempotencyKey = clonePtr(a.IdempotencyKey)
+		a.RollbackInfo = cloneMap(a.RollbackInfo)
+		return a
+	case Error:
+		a.Act = cloneBaseAct(a.Act)
+		a.Severity = clonePtr(a.Severity)
+		a.Category = clonePtr(a.Category)
+		a.Details = cloneMap(a.Details)
+		a.RelatedActID = clonePtr(a.RelatedActID)
+		a.SuggestedAction = clonePtr(a.SuggestedAction)
+		a.UserMessage = clonePtr(a.UserMessage)
+		a.StackTrace = clonePtr(a.StackTrace)
+		return a
+	default:
+		return act
+	}
+}
+
+// cloneBaseAct returns a deep copy of the base Act properties
+func cloneBaseAct(act Act) Act {
+	act.Confidence = clonePtr(act.Confidence)
+	act.Source = clonePtr(act.Source)
+	act.Signature = clonePtr(act.Signature)
+	if act.Metadata != nil {
+		metadata := *act.Metadata
+		metadata.Channel = clonePtr(act.Metadata.Channel)
+		metadata.Language = clonePtr(act.Metadata.Language)
+		metadata.OriginalText = clonePtr(act.Metadata.OriginalText)
+		metadata.ProcessingTimeMs = clonePtr(act.Metadata.ProcessingTimeMs)
+		metadata.AdditionalProperties = cloneMap(act.Metadata.AdditionalProperties)
+		act.Metadata = &metadata
+	}
+	return act
+}
+
+// cloneEntity returns a deep copy of an Entity
+func cloneEntity(entity Entity) Entity {
+	entity.ExternalID = clonePtr(entity.ExternalID)
+	entity.System = clonePtr(entity.System)
+	entity.Version = clonePtr(entity.Version)
+	entity.SchemaURL = clonePtr(entity.SchemaURL)
+	entity.Metadata = cloneMap(entity.Metadata)
+	return entity
+}
+
+// cloneEntityRef returns a deep copy of an EntityRef, preserving its shape
+func cloneEntityRef(ref EntityRef) EntityRef {
+	switch e := ref.(type) {
+	case Entity:
+		return cloneEntity(e)
+	case *Entity:
+		if e == nil {
+			return e
+		}
+		entity := cloneEntity(*e)
+		return &entity
+	default:
+		return cloneValue(ref)
+	}
+}
+
+// cloneParticipant returns a deep copy of a Participant
+func cloneParticipant(participant Participant) Participant {
+	participant.Role = clonePtr(participant.Role)
+	participant.Name = clonePtr(participant.Name)
+	participant.Email = clonePtr(participant.Email)
+	participant.Phone = clonePtr(participant.Phone)
+	participant.ExternalID = clonePtr(participant.ExternalID)
+	participant.System = clonePtr(participant.System)
+	participant.Capabilities = cloneStrings(participant.Capabilities)
+	participant.Permissions = cloneStrings(participant.Permissions)
+	participant.Metadata = cloneMap(participant.Metadata)
+	if participant.Preferences != nil {
+		preferences := *participant.Preferences
+		preferences.Language = clonePtr(participant.Preferences.Language)
+		preferences.Timezone = clonePtr(participant.Preferences.Timezone)
+		preferences.CommunicationChannels = cloneStrings(participant.Preferences.CommunicationChannels)
+		preferences.AdditionalProperties = cloneMap(participant.Preferences.AdditionalProperties)
+		participant.Preferences = &preferences
+	}
+	return participant
+}
+
+// cloneConstraints returns a deep copy of a constraint slice
+func cloneConstraints(constraints []Constraint) []Constraint {
+	if constraints == nil {
+		return nil
+	}
+	clone := make([]Constraint, len(constraints))
+	for i, constraint := range constraints {
+		constraint.Value = cloneValue(constraint.Value)
+		constraint.Message = clonePtr(constraint.Message)
+		constraint.Code = clonePtr(constraint.Code)
+		clone[i] = constraint
+	}
+	return clone
+}
+
+// clonePtr returns a pointer to a copy of the value p points to
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// cloneStrings returns a copy of a string slice
+func cloneStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	clone := make([]string, len(values))
+	copy(clone, values)
+	return clone
+}
+
+// cloneMap returns a deep copy of a JSON-style map
+func cloneMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	clone := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		clone[k] = cloneValue(v)
+	}
+	return clone
+}
+
+// cloneValue returns a deep copy of a JSON-style value. Maps and slices are
+// copied recursively; other values are returned as-is.
+func cloneValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return cloneMap(v)
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		clone := make([]interface{}, len(v))
+		for i, item := range v {
+			clone[i] = cloneValue(item)
+		}
+		return clone
+	case []string:
+		return cloneStrings(v)
+	case Entity:
+		return cloneEntity(v)
+	default:
+		return value
+	}
+}
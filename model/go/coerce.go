@@ -0,0 +1,145 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Value Coercion
+// ============================================================================
+
+// dateLayouts are the layouts accepted when coercing strings to dates
+var dateLayouts = []string{time.RFC3339Nano, time.RFC3339, "2006-01-02"}
+
+// CoerceValue converts a raw fact value to the Go representation of an ask's
+// expected type:
+//
+//	string          -> string (numbers and booleans are formatted)
+//	number          -> float64 (numeric strings are parsed)
+//	boolean         -> bool ("true"/"yes"/"y"/"1" and "false"/"no"/"n"/"0")
+//	date            -> time.Time (RFC 3339 or YYYY-MM-DD strings)
+//	email           -> string, validated with IsValidEmail
+//	phone           -> string, normalized to E.164 with NormalizePhone
+//	object          -> map[string]interface{}
+//	array           -> []interface{}
+//	address         -> string or map[string]interface{}
+//
+// A CoercionError is returned when the value cannot be converted.
+func CoerceValue(value interface{}, expected ExpectedType) (interface{}, error) {
+	switch expected {
+	case ExpectedTypeString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+		if f, ok := toFloat64(value); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+	case ExpectedTypeNumber:
+		if f, ok := toFloat64(value); ok {
+			return f, nil
+		}
+		if s, ok := value.(string); ok {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				return f, nil
+			}
+		}
+	case ExpectedTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "true", "yes", "y", "1":
+				return true, nil
+			case "false", "no", "n", "0":
+				return false, nil
+			}
+		}
+	case ExpectedTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			for _, layout := range dateLayouts {
+				if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+					return t, nil
+				}
+			}
+		}
+	case ExpectedTypeEmail:
+		if s, ok := value.(string); ok {
+			s = strings.TrimSpace(s)
+			if IsValidEmail(s) {
+				return s, nil
+			}
+			return nil, CoercionError{Value: value, ExpectedType: expected, Reason: "invalid email format"}
+		}
+	case ExpectedTypePhone:
+		if s, ok := value.(string); ok {
+			if normalized, err := NormalizePhone(s); err == nil {
+				return normalized, nil
+			}
+			return nil, CoercionError{Value: value, ExpectedType: expected, Reason: "invalid phone format"}
+		}
+	case ExpectedTypeObject:
+		if m, ok := value.(map[string]interface{}); ok {
+			return m, nil
+		}
+	case ExpectedTypeArray:
+		switch v := value.(type) {
+		case []interface{}:
+			return v, nil
+		case []string:
+			items := make([]interface{}, len(v))
+			for i, item := range v {
+				items[i] = item
+			}
+			return items, nil
+		}
+	case ExpectedTypeAddress:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case map[string]interface{}:
+			return v, nil
+		}
+	default:
+		return nil, CoercionError{Value: value, ExpectedType: expected, Reason: "unknown expected type"}
+	}
+
+	return nil, CoercionError{Value: value, ExpectedType: expected, Reason: fmt.Sprintf("cannot convert %T", value)}
+}
+
+// TypedValue returns a fact's value as T. A value already of type T is returned
+// as-is; any other value is converted through its JSON form, which bridges the
+// shapes values take after a JSON round trip: float64 to integer types,
+// []interface{} to typed slices, map[string]interface{} to structs and typed
+// maps, and RFC 3339 strings to time.Time. An error is returned, along with
+// T's zero value, when the fact has no value or the value does not fit T, such
+// as a string read as a number or 2.5 read as an int.
+func TypedValue[T any](f Fact) (T, error) {
+	var zero T
+	if value, ok := f.Value.(T); ok {
+		return value, nil
+	}
+	if f.Value == nil {
+		return zero, fmt.Errorf("fact %s has no value", f.Field)
+	}
+
+	data, err := json.Marshal(f.Value)
+	if err != nil {
+		return zero, fmt.Errorf("fact %s value of type %T cannot be converted to %T: %w", f.Field, f.Value, zero, err)
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return zero, fmt.Errorf("fact %s value of type %T cannot be converted to %T: %w", f.Field, f.Value, zero, err)
+	}
+	return value, nil
+}
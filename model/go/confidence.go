@@ -0,0 +1,78 @@
+package astra
+
+// ============================================================================
+// Confidence Filtering
+// ============================================================================
+
+// ConfidenceOption configures how acts are compared against a confidence threshold
+type ConfidenceOption func(*confidencePolicy)
+
+// confidencePolicy holds the options applied by confidence filtering
+type confidencePolicy struct {
+	includeUnscored bool
+}
+
+// IncludeUnscored sets whether acts without a confidence score are treated as
+// meeting any threshold. This is the default, since acts typed by humans or
+// emitted by systems are usually unscored; pass false to treat them as below
+// every threshold so that they are flagged for review.
+func IncludeUnscored(include bool) ConfidenceOption {
+	return func(p *confidencePolicy) {
+		p.includeUnscored = include
+	}
+}
+
+// newConfidencePolicy applies options over the default policy
+func newConfidencePolicy(options []ConfidenceOption) confidencePolicy {
+	policy := confidencePolicy{includeUnscored: true}
+	for _, option := range options {
+		option(&policy)
+	}
+	return policy
+}
+
+// meets reports whether an act's confidence is at or above the threshold
+func (p confidencePolicy) meets(act ConversationAct, threshold float64) bool {
+	confidence := act.GetAct().Confidence
+	if confidence == nil {
+		return p.includeUnscored
+	}
+	return *confidence >= threshold
+}
+
+// ActsBelowConfidence returns the acts whose confidence is below the threshold,
+// in conversation order. Unscored acts are not returned unless
+// IncludeUnscored(false) is passed.
+func (c *Conversation) ActsBelowConfidence(threshold float64, options ...ConfidenceOption) []ConversationAct {
+	policy := newConfidencePolicy(options)
+
+	var acts []ConversationAct
+	for _, act := range c.Acts {
+		if !policy.meets(act, threshold) {
+			acts = append(acts, act)
+		}
+	}
+	return acts
+}
+
+// FilterByConfidence returns a deep copy of the conversation containing only the
+// acts whose confidence is at or above min. Unscored acts are kept unless
+// IncludeUnscored(false) is passed. The copy's metadata counts are recomputed
+// for the remaining acts; the original conversation is left untouched.
+func (c *Conversation) FilterByConfidence(min float64, options ...ConfidenceOption) Conversation {
+	policy := newConfidencePolicy(options)
+
+	filtered := c.Clone()
+	acts := make([]ConversationAct, 0, len(filtered.Acts))
+	for _, act := range filtered.Acts {
+		if policy.meets(act, min) {
+			acts = append(acts, act)
+		}
+	}
+	filtered.Acts = acts
+
+	if filtered.Metadata != nil {
+		filtered.updateMetadata()
+	}
+	return filtered
+}
@@ -0,0 +1,508 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Constraint Evaluation
+// ============================================================================
+
+// ConstraintViolation describes a value that fails an ask constraint
+type ConstraintViolation struct {
+	// Constraint that was violated
+	Constraint Constraint
+	// Value that was evaluated
+	Value interface{}
+	// Human-readable reason, taken from the constraint message when set
+	Message string
+}
+
+func (v ConstraintViolation) Error() string {
+	return fmt.Sprintf("constraint %s violated: %s (value: %v)", v.Constraint.Type, v.Message, v.Value)
+}
+
+// EvaluateConstraints checks a value against a list of constraints and returns
+// every violation. Constraints that do not apply to the value's type (e.g. a
+// length constraint on a boolean) are skipped, as are custom constraints.
+// Violations are described in the locale set with SetMessageLocale.
+func EvaluateConstraints(value interface{}, constraints []Constraint) []ConstraintViolation {
+	return EvaluateConstraintsInLocale("", value, constraints)
+}
+
+// EvaluateConstraintsInLocale is like EvaluateConstraints but describes
+// violations in the given locale, such as a participant's MessageLocale
+func EvaluateConstraintsInLocale(locale string, value interface{}, constraints []Constraint) []ConstraintViolation {
+	var violations []ConstraintViolation
+	for _, constraint := range constraints {
+		if reason, ok := evaluateConstraint(locale, value, constraint); !ok {
+			if message := constraint.LocalizedMessage(locale); message != "" {
+				reason = message
+			}
+			violations = append(violations, ConstraintViolation{
+				Constraint: constraint,
+				Value:      value,
+				Message:    reason,
+			})
+		}
+	}
+	return violations
+}
+
+// LocalizedMessage returns the constraint message in the given locale. A
+// message set by one of the constraint builders is rendered again from the
+// message catalogs; any other message, including one that replaced a
+// builder's, is returned as it is. An empty string is returned when the
+// constraint has no message.
+func (c Constraint) LocalizedMessage(locale string) string {
+	if c.Message == nil {
+		return ""
+	}
+	if m := c.builderMessage; m != nil && *c.Message == m.text {
+		return LocalizeMessage(locale, m.key, builderMessageArgs(m.key, c.Value)...)
+	}
+	return *c.Message
+}
+
+// evaluateConstraint checks a single constraint, returning a reason when it fails
+func evaluateConstraint(locale string, value interface{}, constraint Constraint) (string, bool) {
+	switch constraint.Type {
+	case ConstraintTypeRequired:
+		if value == nil || value == "" {
+			return LocalizeMessage(locale, MessageValueRequired), false
+		}
+	case ConstraintTypeMinLength, ConstraintTypeMaxLength:
+		limit, ok := toFloat64(constraint.Value)
+		if !ok {
+			return fmt.Sprintf("invalid length limit: %v", constraint.Value), false
+		}
+		length, ok := valueLength(value)
+		if !ok {
+			return "", true
+		}
+		if constraint.Type == ConstraintTypeMinLength && float64(length) < limit {
+			return LocalizeMessage(locale, MessageBelowMinLength, length, limit), false
+		}
+		if constraint.Type == ConstraintTypeMaxLength && float64(length) > limit {
+			return LocalizeMessage(locale, MessageAboveMaxLength, length, limit), false
+		}
+	case ConstraintTypePattern:
+		s, ok := value.(string)
+		if !ok {
+			return "", true
+		}
+		pattern, ok := constraint.Value.(string)
+		if !ok {
+			return fmt.Sprintf("invalid pattern: %v", constraint.Value), false
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Sprintf("invalid pattern: %v", err), false
+		}
+		if !re.MatchString(s) {
+			return LocalizeMessage(locale, MessagePatternMismatch, pattern), false
+		}
+	case ConstraintTypeFormat:
+		s, ok := value.(string)
+		if !ok {
+			return "", true
+		}
+		var format FormatType
+		switch f := constraint.Value.(type) {
+		case FormatType:
+			format = f
+		case string:
+			format = FormatType(f)
+		default:
+			return fmt.Sprintf("invalid format: %v", constraint.Value), false
+		}
+		if !isValidFormat(format, s) {
+			return LocalizeMessage(locale, MessageInvalidFormat, format), false
+		}
+	case ConstraintTypeRange:
+		number, ok := toFloat64(value)
+		if !ok {
+			return "", true
+		}
+		rangeValue, err := toRangeConstraint(constraint.Value)
+		if err != nil {
+			return err.Error(), false
+		}
+		inclusive := rangeValue.Inclusive == nil || *rangeValue.Inclusive
+		if rangeValue.Min != nil && (number < *rangeValue.Min || (!inclusive && number == *rangeValue.Min)) {
+			return LocalizeMessage(locale, MessageBelowMinimum, *rangeValue.Min), false
+		}
+		if rangeValue.Max != nil && (number > *rangeValue.Max || (!inclusive && number == *rangeValue.Max)) {
+			return LocalizeMessage(locale, MessageAboveMaximum, *rangeValue.Max), false
+		}
+	case ConstraintTypeEnum:
+		if !enumContains(constraint.Value, value) {
+			return LocalizeMessage(locale, MessageNotInEnum, constraint.Value), false
+		}
+	}
+	return "", true
+}
+
+// valueLength returns the length of a string (in characters) or slice
+func valueLength(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case string:
+		return utf8.RuneCountInString(v), true
+	case []interface{}:
+		return len(v), true
+	case []string:
+		return len(v), true
+	default:
+		return 0, false
+	}
+}
+
+// toRangeConstraint converts a range constraint value, including one decoded
+// from JSON, into a RangeConstraint
+func toRangeConstraint(value interface{}) (RangeConstraint, error) {
+	switch v := value.(type) {
+	case RangeConstraint:
+		return v, nil
+	case *RangeConstraint:
+		if v != nil {
+			return *v, nil
+		}
+	case map[string]interface{}:
+		var rangeValue RangeConstraint
+		data, err := json.Marshal(v)
+		if err == nil {
+			err = json.Unmarshal(data, &rangeValue)
+		}
+		if err == nil {
+			return rangeValue, nil
+		}
+	}
+	return RangeConstraint{}, fmt.Errorf("invalid range: %v", value)
+}
+
+// enumContains reports whether value is one of the allowed enum values
+func enumContains(allowed interface{}, value interface{}) bool {
+	switch values := allowed.(type) {
+	case []string:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		for _, v := range values {
+			if v == s {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range values {
+			if scalarsEqual(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scalarsEqual compares two scalar values, treating numbers of different Go
+// types as equal when they have the same value
+func scalarsEqual(a, b interface{}) bool {
+	if af, ok := toFloat64(a); ok {
+		bf, ok := toFloat64(b)
+		return ok && af == bf
+	}
+	switch a.(type) {
+	case string, bool, nil:
+		return a == b
+	}
+	return false
+}
+
+// ============================================================================
+// Answer Validation
+// ============================================================================
+
+// AnswerError describes a fact whose value does not satisfy the ask it
+// answers. Err is the CoercionError or ConstraintViolation found.
+type AnswerError struct {
+	// ID of the ask being answered
+	AskID string
+	// ID of the fact answering it
+	FactID string
+	// Field asked for and set
+	Field string
+	// Reason the value was rejected
+	Err error
+}
+
+func (e AnswerError) Error() string {
+	return fmt.Sprintf("fact %s answering ask %s for field %s: %v", e.FactID, e.AskID, e.Field, e.Err)
+}
+
+// Unwrap returns the CoercionError or ConstraintViolation
+func (e AnswerError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateAnswers checks each fact that answers an ask, as paired by
+// MatchAsksToFacts, against what the ask requested. When the ask has an
+// expected type the value must coerce to it with CoerceValue, and the
+// ask's constraints are then evaluated against the coerced value, so that,
+// say, a numeric string answering a number ask is range-checked as a number.
+// A value that cannot be coerced is reported once, without evaluating the
+// constraints. Problems are returned as AnswerErrors in the order of the asks.
+func (c *Conversation) ValidateAnswers() []error {
+	matches, _ := c.MatchAsksToFacts()
+	if len(matches) == 0 {
+		return nil
+	}
+
+	facts := make(map[string]Fact)
+	for _, act := range c.Acts {
+		if fact, ok := act.(Fact); ok {
+			facts[fact.ID] = fact
+		}
+	}
+
+	var errs []error
+	for _, act := range c.Acts {
+		ask, ok := act.(Ask)
+		if !ok {
+			continue
+		}
+		factID, ok := matches[ask.ID]
+		if !ok {
+			continue
+		}
+		answerError := AnswerError{AskID: ask.ID, FactID: factID, Field: ask.Field}
+
+		value := facts[factID].Value
+		if ask.ExpectedType != nil {
+			coerced, err := CoerceValue(value, *ask.ExpectedType)
+			if err != nil {
+				answerError.Err = err
+				errs = append(errs, answerError)
+				continue
+			}
+			value = coerced
+		}
+		for _, violation := range EvaluateConstraints(value, ask.Constraints) {
+			answerError.Err = violation
+			errs = append(errs, answerError)
+		}
+	}
+	return errs
+}
+
+// ============================================================================
+// JSON Schema Conversion
+// ============================================================================
+
+// jsonSchemaFormats maps constraint formats to JSON Schema format names.
+// Phone numbers have no standard format; "phone" is emitted and, like any
+// unknown format, is treated as an annotation by draft 2020-12 validators.
+var jsonSchemaFormats = map[FormatType]string{
+	FormatTypeEmail:    "email",
+	FormatTypePhone:    "phone",
+	FormatTypeURL:      "uri",
+	FormatTypeDate:     "date",
+	FormatTypeTime:     "time",
+	FormatTypeDateTime: "date-time",
+	FormatTypeUUID:     "uuid",
+	FormatTypeIPv4:     "ipv4",
+	FormatTypeIPv6:     "ipv6",
+}
+
+// ConstraintsToSchema describes the answer to an ask as a JSON Schema (draft
+// 2020-12) fragment, suitable as the schema of a form field. The expected
+// type sets "type", with date, email, and phone answers being strings of the
+// matching format and address answers either a string or an object. The
+// constraints then map to keywords:
+//
+//	min_length, max_length -> minLength, maxLength (minItems, maxItems for arrays)
+//	pattern                -> pattern
+//	format                 -> format
+//	enum                   -> enum
+//	range                  -> minimum, maximum, or their exclusive forms
+//
+// When several constraints set the same keyword, length limits and range
+// bounds keep the tightest value and the last pattern, format, or enum wins.
+// Required and custom constraints have no equivalent in a field's own schema
+// and are skipped, as are constraint values that cannot be read. Messages and
+// codes are not carried over.
+func ConstraintsToSchema(constraints []Constraint, expected ExpectedType) Schema {
+	schema := Schema{}
+	switch expected {
+	case ExpectedTypeString, ExpectedTypeNumber, ExpectedTypeBoolean, ExpectedTypeObject, ExpectedTypeArray:
+		schema["type"] = string(expected)
+	case ExpectedTypeDate, ExpectedTypeEmail, ExpectedTypePhone:
+		schema["type"] = "string"
+		schema["format"] = jsonSchemaFormats[FormatType(expected)]
+	case ExpectedTypeAddress:
+		schema["type"] = []string{"string", "object"}
+	}
+
+	minLength, maxLength := "minLength", "maxLength"
+	if expected == ExpectedTypeArray {
+		minLength, maxLength = "minItems", "maxItems"
+	}
+
+	for _, constraint := range constraints {
+		switch constraint.Type {
+		case ConstraintTypeMinLength:
+			if limit, ok := toFloat64(constraint.Value); ok {
+				tightenBound(schema, minLength, int(limit), func(a, b int) bool { return a > b })
+			}
+		case ConstraintTypeMaxLength:
+			if limit, ok := toFloat64(constraint.Value); ok {
+				tightenBound(schema, maxLength, int(limit), func(a, b int) bool { return a < b })
+			}
+		case ConstraintTypePattern:
+			if pattern, ok := constraint.Value.(string); ok {
+				schema["pattern"] = pattern
+			}
+		case ConstraintTypeFormat:
+			var format FormatType
+			switch f := constraint.Value.(type) {
+			case FormatType:
+				format = f
+			case string:
+				format = FormatType(f)
+			}
+			if name, ok := jsonSchemaFormats[format]; ok {
+				schema["format"] = name
+			}
+		case ConstraintTypeEnum:
+			switch values := constraint.Value.(type) {
+			case []string:
+				enum := make([]interface{}, len(values))
+				for i, value := range values {
+					enum[i] = value
+				}
+				schema["enum"] = enum
+			case []interface{}:
+				schema["enum"] = append([]interface{}(nil), values...)
+			}
+		case ConstraintTypeRange:
+			rangeValue, err := toRangeConstraint(constraint.Value)
+			if err != nil {
+				continue
+			}
+			minimum, maximum := "minimum", "maximum"
+			if rangeValue.Inclusive != nil && !*rangeValue.Inclusive {
+				minimum, maximum = "exclusiveMinimum", "exclusiveMaximum"
+			}
+			if rangeValue.Min != nil {
+				tightenBound(schema, minimum, *rangeValue.Min, func(a, b float64) bool { return a > b })
+			}
+			if rangeValue.Max != nil {
+				tightenBound(schema, maximum, *rangeValue.Max, func(a, b float64) bool { return a < b })
+			}
+		}
+	}
+	return schema
+}
+
+// tightenBound sets a numeric keyword unless it already holds a value at
+// least as tight, as judged by tighter
+func tightenBound[T int | float64](schema Schema, keyword string, value T, tighter func(a, b T) bool) {
+	if current, ok := schema[keyword].(T); ok && !tighter(value, current) {
+		return
+	}
+	schema[keyword] = value
+}
+
+// SchemaToConstraints is the inverse of ConstraintsToSchema: it reads the
+// keywords of a JSON Schema fragment back into constraints, in the order
+// min_length, max_length, pattern, format, enum, range. minItems and maxItems
+// become length constraints like minLength and maxLength. Inclusive and
+// exclusive bounds become separate range constraints, since one range is
+// either inclusive or exclusive at both ends. Formats without a constraint
+// equivalent and all other keywords, including "type", are ignored. Length,
+// format, and string enum constraints are built with the same constructors
+// as hand-written ones, so they carry the usual messages.
+func SchemaToConstraints(schema Schema) []Constraint {
+	var constraints []Constraint
+
+	if limit, ok := schemaNumber(schema, "minLength", "minItems"); ok {
+		constraints = append(constraints, MinLengthConstraint(int(limit)))
+	}
+	if limit, ok := schemaNumber(schema, "maxLength", "maxItems"); ok {
+		constraints = append(constraints, MaxLengthConstraint(int(limit)))
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		constraints = append(constraints, NewConstraint(ConstraintTypePattern, WithConstraintValue(pattern)))
+	}
+
+	if name, ok := schema["format"].(string); ok {
+		switch name {
+		case "email":
+			constraints = append(constraints, EmailFormatConstraint())
+		case "phone":
+			constraints = append(constraints, PhoneFormatConstraint())
+		default:
+			for format, schemaName := range jsonSchemaFormats {
+				if schemaName == name {
+					constraints = append(constraints, NewConstraint(ConstraintTypeFormat, WithConstraintValue(format)))
+					break
+				}
+			}
+		}
+	}
+
+	if constraint, ok := enumToConstraint(schema["enum"]); ok {
+		constraints = append(constraints, constraint)
+	}
+
+	for _, keywords := range [][2]string{{"minimum", "maximum"}, {"exclusiveMinimum", "exclusiveMaximum"}} {
+		var min, max *float64
+		if value, ok := toFloat64(schema[keywords[0]]); ok {
+			min = &value
+		}
+		if value, ok := toFloat64(schema[keywords[1]]); ok {
+			max = &value
+		}
+		if min != nil || max != nil {
+			constraints = append(constraints, RangeConstraint(min, max, keywords[0] == "minimum"))
+		}
+	}
+
+	return constraints
+}
+
+// schemaNumber returns the value of the first of the keywords that holds a number
+func schemaNumber(schema Schema, keywords ...string) (float64, bool) {
+	for _, keyword := range keywords {
+		if value, ok := toFloat64(schema[keyword]); ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// enumToConstraint converts an enum keyword, building a string enum with
+// EnumConstraint when every value is a string
+func enumToConstraint(value interface{}) (Constraint, bool) {
+	var values []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		values = v
+	case []string:
+		return EnumConstraint(v), true
+	default:
+		return Constraint{}, false
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, item := range values {
+		s, ok := item.(string)
+		if !ok {
+			return NewConstraint(ConstraintTypeEnum, WithConstraintValue(append([]interface{}(nil), values...))), true
+		}
+		strs = append(strs, s)
+	}
+	return EnumConstraint(strs), true
+}
@@ -0,0 +1,80 @@
+package astra
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ============================================================================
+// Tabular Export
+// ============================================================================
+
+// factsCSVHeader is the header row written by FactsCSV
+var factsCSVHeader = []string{
+	"conversation_id", "act_id", "timestamp", "speaker", "entity_id",
+	"field", "operation", "value", "validation_status",
+}
+
+// FactsCSV writes the conversation's facts to w as CSV, one row per fact in
+// conversation order after a header row of conversation_id, act_id,
+// timestamp, speaker, entity_id, field, operation, value, and
+// validation_status. Timestamps are RFC 3339. String values are written as
+// is; other values, including objects and arrays, as JSON. Unset optional
+// columns are left empty.
+func (c Conversation) FactsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(factsCSVHeader); err != nil {
+		return err
+	}
+
+	for _, act := range c.Acts {
+		fact, ok := act.(Fact)
+		if !ok {
+			continue
+		}
+		entityID, err := GetEntityID(fact.Entity)
+		if err != nil {
+			return fmt.Errorf("fact %s: %w", fact.ID, err)
+		}
+		value, err := csvValue(fact.Value)
+		if err != nil {
+			return fmt.Errorf("fact %s: cannot encode value: %w", fact.ID, err)
+		}
+		var operation, status string
+		if fact.Operation != nil {
+			operation = string(*fact.Operation)
+		}
+		if fact.ValidationStatus != nil {
+			status = string(*fact.ValidationStatus)
+		}
+
+		row := []string{
+			c.ID, fact.ID, fact.Timestamp.Format(time.RFC3339Nano), fact.Speaker, entityID,
+			fact.Field, operation, value, status,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvValue renders a fact value for a CSV cell
+func csvValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
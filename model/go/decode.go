@@ -0,0 +1,282 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Document Decoding
+// ============================================================================
+
+// conversationActType is the reflected ConversationAct interface
+var conversationActType = reflect.TypeOf((*ConversationAct)(nil)).Elem()
+
+// conversationDocument has the fields of Conversation without its custom
+// unmarshaling, which needs the encoded acts
+type conversationDocument Conversation
+
+// decodeConversationDocument builds a Conversation from a document already
+// decoded into generic JSON values, so that a conversation can be checked
+// against its schema and decoded from a single parse. Values are decoded as
+// encoding/json would decode the same document: members match fields by JSON
+// name, preferring an exact match to a case-insensitive one; unknown members
+// are ignored; null clears pointers, slices, and maps; and types with their
+// own UnmarshalJSON, such as time.Time and the metadata types, decode their
+// members themselves. Acts are decoded by type like UnmarshalAct.
+func decodeConversationDocument(document interface{}) (Conversation, error) {
+	var c conversationDocument
+	if err := decodeDocumentValue(document, reflect.ValueOf(&c).Elem()); err != nil {
+		return Conversation{}, err
+	}
+	if c.Acts == nil {
+		c.Acts = make([]ConversationAct, 0)
+	}
+	return Conversation(c), nil
+}
+
+// decodeActDocument decodes one act of a conversation document by its type
+func decodeActDocument(value interface{}) (ConversationAct, error) {
+	members, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to determine act type: act is %s, not an object", documentKind(value))
+	}
+	actType, ok := members["type"].(string)
+	if !ok && members["type"] != nil {
+		return nil, fmt.Errorf("failed to determine act type: type is %s, not a string", documentKind(members["type"]))
+	}
+	if err := checkActTimestampValue(members["timestamp"]); err != nil {
+		return nil, err
+	}
+
+	var act interface{}
+	switch ActType(actType) {
+	case ActTypeAsk:
+		act = &Ask{}
+	case ActTypeFact:
+		act = &Fact{}
+	case ActTypeConfirm:
+		act = &Confirm{}
+	case ActTypeCommit:
+		act = &Commit{}
+	case ActTypeError:
+		act = &Error{}
+	default:
+		return nil, fmt.Errorf("unknown act type: %s", actType)
+	}
+
+	target := reflect.ValueOf(act).Elem()
+	if err := decodeDocumentValue(value, target); err != nil {
+		return nil, err
+	}
+	return target.Interface().(ConversationAct), nil
+}
+
+// decodeDocumentValue decodes a generic JSON value into target
+func decodeDocumentValue(value interface{}, target reflect.Value) error {
+	if value == nil {
+		switch target.Kind() {
+		case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+			target.Set(reflect.Zero(target.Type()))
+		}
+		return nil
+	}
+
+	if target.Type() == conversationActType {
+		act, err := decodeActDocument(value)
+		if err != nil {
+			return err
+		}
+		target.Set(reflect.ValueOf(act))
+		return nil
+	}
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return decodeDocumentValue(value, target.Elem())
+	}
+	if unmarshaler, ok := target.Addr().Interface().(json.Unmarshaler); ok {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		return unmarshaler.UnmarshalJSON(data)
+	}
+
+	mismatch := fmt.Errorf("json: cannot unmarshal %s into Go value of type %s", documentKind(value), target.Type())
+	switch target.Kind() {
+	case reflect.Interface:
+		if target.NumMethod() != 0 {
+			return mismatch
+		}
+		target.Set(reflect.ValueOf(value))
+	case reflect.Struct:
+		members, ok := value.(map[string]interface{})
+		if !ok {
+			return mismatch
+		}
+		fields := documentFields(target.Type())
+		for key, member := range members {
+			index, ok := matchDocumentField(fields, key)
+			if !ok {
+				continue
+			}
+			if err := decodeDocumentValue(member, target.FieldByIndex(index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		members, ok := value.(map[string]interface{})
+		if !ok || target.Type().Key().Kind() != reflect.String {
+			return mismatch
+		}
+		if target.IsNil() {
+			target.Set(reflect.MakeMapWithSize(target.Type(), len(members)))
+		}
+		for key, member := range members {
+			element := reflect.New(target.Type().Elem()).Elem()
+			if err := decodeDocumentValue(member, element); err != nil {
+				return err
+			}
+			target.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), element)
+		}
+	case reflect.Slice:
+		items, ok := value.([]interface{})
+		if !ok {
+			return mismatch
+		}
+		slice := reflect.MakeSlice(target.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeDocumentValue(item, slice.Index(i)); err != nil {
+				if target.Type().Elem() == conversationActType {
+					return fmt.Errorf("failed to unmarshal act at index %d: %w", i, err)
+				}
+				return err
+			}
+		}
+		target.Set(slice)
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return mismatch
+		}
+		target.SetString(s)
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return mismatch
+		}
+		target.SetBool(b)
+	case reflect.Float32, reflect.Float64:
+		n, ok := value.(float64)
+		if !ok || target.OverflowFloat(n) {
+			return mismatch
+		}
+		target.SetFloat(n)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) || target.OverflowInt(int64(n)) {
+			return mismatch
+		}
+		target.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := value.(float64)
+		if !ok || n < 0 || n != math.Trunc(n) || target.OverflowUint(uint64(n)) {
+			return mismatch
+		}
+		target.SetUint(uint64(n))
+	default:
+		return mismatch
+	}
+	return nil
+}
+
+// documentKind names the JSON kind of a generic value for error messages
+func documentKind(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case float64:
+		return fmt.Sprintf("number %v", v)
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// documentField is a struct field reachable by a JSON member name, with the
+// index path through any embedded structs
+type documentField struct {
+	name  string
+	index []int
+}
+
+// documentFieldCache holds the result of documentFields for each struct type
+var documentFieldCache sync.Map
+
+// documentFields returns the fields of a struct that encoding/json would
+// decode, including those promoted from embedded structs, shallowest first
+func documentFields(t reflect.Type) []documentField {
+	if fields, ok := documentFieldCache.Load(t); ok {
+		return fields.([]documentField)
+	}
+
+	var fields []documentField
+	var walk func(t reflect.Type, prefix []int)
+	walk = func(t reflect.Type, prefix []int) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			index := append(append([]int(nil), prefix...), i)
+			if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+				walk(field.Type, index)
+				continue
+			}
+			if !field.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			fields = append(fields, documentField{name: name, index: index})
+		}
+	}
+	walk(t, nil)
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return len(fields[i].index) < len(fields[j].index)
+	})
+	documentFieldCache.Store(t, fields)
+	return fields
+}
+
+// matchDocumentField finds the field a JSON member decodes into
+func matchDocumentField(fields []documentField, key string) ([]int, bool) {
+	for _, field := range fields {
+		if field.name == key {
+			return field.index, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.name, key) {
+			return field.index, true
+		}
+	}
+	return nil, false
+}
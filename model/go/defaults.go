@@ -0,0 +1,76 @@
+package astra
+
+// ============================================================================
+// Schema Defaults
+// ============================================================================
+
+// Defaults declared by the JSON schemas, applied by ApplyDefaults
+const (
+	defaultConversationStatus = ConversationStatusActive
+	defaultAskRequired        = true
+	defaultRetryCount         = 0
+	defaultMaxRetries         = 3
+	defaultFactOperation      = FieldOperationSet
+	defaultValidationStatus   = ValidationStatusPending
+	defaultConfirmAwaiting    = true
+	defaultCommitStatus       = CommitStatusPending
+	defaultErrorSeverity      = ErrorSeverityError
+)
+
+// ApplyDefaults returns a copy of act with unset optional fields filled in
+// with the defaults declared by the act schemas:
+//
+//	ask     required true, retry_count 0, max_retries 3
+//	fact    operation set, validation_status pending
+//	confirm awaiting true, unless confirmed is already set
+//	commit  status pending, retry_count 0, max_retries 3
+//	error   severity error
+//
+// A confirm that records its answer is not awaiting one, so awaiting is left
+// unset there rather than contradicting confirmed. Fields that are already
+// set are never changed. Acts of other types are returned as they are.
+func ApplyDefaults(act ConversationAct) ConversationAct {
+	switch a := act.(type) {
+	case Ask:
+		a.Required = defaultPtr(a.Required, defaultAskRequired)
+		a.RetryCount = defaultPtr(a.RetryCount, defaultRetryCount)
+		a.MaxRetries = defaultPtr(a.MaxRetries, defaultMaxRetries)
+		return a
+	case Fact:
+		a.Operation = defaultPtr(a.Operation, defaultFactOperation)
+		a.ValidationStatus = defaultPtr(a.ValidationStatus, defaultValidationStatus)
+		return a
+	case Confirm:
+		if a.Confirmed == nil {
+			a.Awaiting = defaultPtr(a.Awaiting, defaultConfirmAwaiting)
+		}
+		return a
+	case Commit:
+		a.Status = defaultPtr(a.Status, defaultCommitStatus)
+		a.RetryCount = defaultPtr(a.RetryCount, defaultRetryCount)
+		a.MaxRetries = defaultPtr(a.MaxRetries, defaultMaxRetries)
+		return a
+	case Error:
+		a.Severity = defaultPtr(a.Severity, defaultErrorSeverity)
+		return a
+	default:
+		return act
+	}
+}
+
+// ApplyDefaults fills in the conversation's status, active unless set, and
+// applies ApplyDefaults to each of its acts
+func (c *Conversation) ApplyDefaults() {
+	c.Status = defaultPtr(c.Status, defaultConversationStatus)
+	for i, act := range c.Acts {
+		c.Acts[i] = ApplyDefaults(act)
+	}
+}
+
+// defaultPtr returns p, or a pointer to value when p is nil
+func defaultPtr[T any](p *T, value T) *T {
+	if p != nil {
+		return p
+	}
+	return &value
+}
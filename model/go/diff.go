@@ -0,0 +1,355 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Conversation Diffing
+// ============================================================================
+
+// FieldChange records a single field whose value differs between two versions.
+// Field is the JSON path of the field, with nested object keys joined by dots
+// (for example "metadata.original_text"). Values are in their JSON form; a nil
+// Old or New means the field was absent on that side.
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// ActChange lists the field changes to an act present in both conversations
+type ActChange struct {
+	ID      string
+	Type    ActType
+	Changes []FieldChange
+}
+
+// ParticipantChange lists the field changes to a participant present in both
+// conversations
+type ParticipantChange struct {
+	ID      string
+	Changes []FieldChange
+}
+
+// ConversationDiff describes how one version of a conversation differs from
+// another. Acts and participants are matched by ID. Added and modified entries
+// follow the order of the new conversation; removed entries follow the old one.
+// Added acts and participants are copies, so the diff does not change when the
+// new conversation is edited afterwards.
+type ConversationDiff struct {
+	AddedActs            []ConversationAct
+	RemovedActs          []string
+	ModifiedActs         []ActChange
+	AddedParticipants    []Participant
+	RemovedParticipants  []string
+	ModifiedParticipants []ParticipantChange
+}
+
+// DiffConversations compares two versions of a conversation, such as a raw
+// extraction and its human-corrected copy, and reports the acts and
+// participants that were added, removed, or modified
+func DiffConversations(old, new Conversation) ConversationDiff {
+	var diff ConversationDiff
+
+	oldActs := make(map[string]ConversationAct, len(old.Acts))
+	for _, act := range old.Acts {
+		oldActs[act.GetAct().ID] = act
+	}
+	newActIDs := make(map[string]bool, len(new.Acts))
+	for _, act := range new.Acts {
+		id := act.GetAct().ID
+		newActIDs[id] = true
+
+		previous, ok := oldActs[id]
+		if !ok {
+			diff.AddedActs = append(diff.AddedActs, CloneAct(act))
+			continue
+		}
+		if changes := diffFields(previous, act); len(changes) > 0 {
+			diff.ModifiedActs = append(diff.ModifiedActs, ActChange{ID: id, Type: act.GetType(), Changes: changes})
+		}
+	}
+	for _, act := range old.Acts {
+		if id := act.GetAct().ID; !newActIDs[id] {
+			diff.RemovedActs = append(diff.RemovedActs, id)
+		}
+	}
+
+	oldParticipants := make(map[string]Participant, len(old.Participants))
+	for _, participant := range old.Participants {
+		oldParticipants[participant.ID] = participant
+	}
+	newParticipantIDs := make(map[string]bool, len(new.Participants))
+	for _, participant := range new.Participants {
+		newParticipantIDs[participant.ID] = true
+
+		previous, ok := oldParticipants[participant.ID]
+		if !ok {
+			diff.AddedParticipants = append(diff.AddedParticipants, cloneParticipant(participant))
+			continue
+		}
+		if changes := diffFields(previous, participant); len(changes) > 0 {
+			diff.ModifiedParticipants = append(diff.ModifiedParticipants, ParticipantChange{ID: participant.ID, Changes: changes})
+		}
+	}
+	for _, participant := range old.Participants {
+		if !newParticipantIDs[participant.ID] {
+			diff.RemovedParticipants = append(diff.RemovedParticipants, participant.ID)
+		}
+	}
+
+	return diff
+}
+
+// IsEmpty reports whether the diff contains no changes
+func (d ConversationDiff) IsEmpty() bool {
+	return len(d.AddedActs) == 0 && len(d.RemovedActs) == 0 && len(d.ModifiedActs) == 0 &&
+		len(d.AddedParticipants) == 0 && len(d.RemovedParticipants) == 0 && len(d.ModifiedParticipants) == 0
+}
+
+// String renders the diff as text, one line per added, removed, or modified
+// act or participant, with each modified field indented beneath it:
+//
+//	~ act act_1 (fact)
+//	    value: "jane@exmaple.com" -> "jane@example.com"
+//	+ act act_2 (confirm)
+//	- act act_0
+//	+ participant agent_789
+func (d ConversationDiff) String() string {
+	if d.IsEmpty() {
+		return "no changes"
+	}
+
+	var b strings.Builder
+	writeChanges := func(changes []FieldChange) {
+		for _, change := range changes {
+			fmt.Fprintf(&b, "    %s: %s -> %s\n", change.Field, diffValue(change.Old), diffValue(change.New))
+		}
+	}
+
+	for _, change := range d.ModifiedActs {
+		fmt.Fprintf(&b, "~ act %s (%s)\n", change.ID, change.Type)
+		writeChanges(change.Changes)
+	}
+	for _, act := range d.AddedActs {
+		fmt.Fprintf(&b, "+ act %s (%s)\n", act.GetAct().ID, act.GetType())
+	}
+	for _, id := range d.RemovedActs {
+		fmt.Fprintf(&b, "- act %s\n", id)
+	}
+	for _, change := range d.ModifiedParticipants {
+		fmt.Fprintf(&b, "~ participant %s\n", change.ID)
+		writeChanges(change.Changes)
+	}
+	for _, participant := range d.AddedParticipants {
+		fmt.Fprintf(&b, "+ participant %s\n", participant.ID)
+	}
+	for _, id := range d.RemovedParticipants {
+		fmt.Fprintf(&b, "- participant %s\n", id)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// ValuesEqual reports whether two free-form values, such as Fact values, are
+// equal under JSON semantics. Numbers compare by value regardless of Go type,
+// so int(42) equals float64(42); strings and booleans compare by value
+// regardless of named type; pointers are followed; and maps with string keys,
+// slices, and arrays are compared element by element. Other values, such as
+// structs, are compared in their decoded JSON form, falling back to
+// reflect.DeepEqual when they cannot be marshaled.
+func ValuesEqual(a, b interface{}) bool {
+	va, vb := indirectValue(reflect.ValueOf(a)), indirectValue(reflect.ValueOf(b))
+	if !va.IsValid() || !vb.IsValid() {
+		return va.IsValid() == vb.IsValid()
+	}
+
+	x, aIsNumber := numberValue(va)
+	y, bIsNumber := numberValue(vb)
+	if aIsNumber || bIsNumber {
+		return aIsNumber && bIsNumber && x != nil && y != nil && x.Cmp(y) == 0
+	}
+
+	switch va.Kind() {
+	case reflect.String:
+		return vb.Kind() == reflect.String && va.String() == vb.String()
+	case reflect.Bool:
+		return vb.Kind() == reflect.Bool && va.Bool() == vb.Bool()
+	case reflect.Map:
+		if vb.Kind() != reflect.Map || va.Type().Key().Kind() != reflect.String || vb.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if va.Len() != vb.Len() {
+			return false
+		}
+		for _, key := range va.MapKeys() {
+			other := vb.MapIndex(reflect.ValueOf(key.String()).Convert(vb.Type().Key()))
+			if !other.IsValid() || !ValuesEqual(va.MapIndex(key).Interface(), other.Interface()) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if vb.Kind() != reflect.Slice && vb.Kind() != reflect.Array {
+			break
+		}
+		if va.Len() != vb.Len() {
+			return false
+		}
+		for i := 0; i < va.Len(); i++ {
+			if !ValuesEqual(va.Index(i).Interface(), vb.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+
+	normalizedA, errA := normalizeJSONValue(a)
+	normalizedB, errB := normalizeJSONValue(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+// numberValue returns a numeric value exactly, so that large integers keep
+// their precision. NaN is reported as a number with a nil value, equal to
+// nothing.
+func numberValue(v reflect.Value) (*big.Float, bool) {
+	if n, ok := v.Interface().(json.Number); ok {
+		f, _, err := big.ParseFloat(string(n), 10, 256, big.ToNearestEven)
+		return f, err == nil
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) {
+			return nil, true
+		}
+		return new(big.Float).SetFloat64(v.Float()), true
+	}
+	return nil, false
+}
+
+// indirectValue follows pointers and interfaces, returning the zero Value for nil
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// normalizeJSONValue round-trips a value through JSON so that equal values share
+// one Go representation
+func normalizeJSONValue(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// diffFields compares two values field by field in their JSON object form.
+// Null members are treated as absent, so an explicit null and a missing field
+// compare equal. Values that cannot be marshaled are
+// compared whole and reported as a single change with an empty Field.
+func diffFields(old, new interface{}) []FieldChange {
+	oldFields, errOld := normalizeJSONValue(old)
+	newFields, errNew := normalizeJSONValue(new)
+	if errOld != nil || errNew != nil {
+		if reflect.DeepEqual(old, new) {
+			return nil
+		}
+		return []FieldChange{{Old: old, New: new}}
+	}
+	return diffObjects("", asObject(pruneNulls(oldFields)), asObject(pruneNulls(newFields)))
+}
+
+// pruneNulls removes null members from decoded JSON objects, recursively
+func pruneNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, member := range v {
+			if member == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = pruneNulls(member)
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = pruneNulls(element)
+		}
+	}
+	return value
+}
+
+// diffObjects compares two JSON objects, descending into nested objects and
+// reporting changes in sorted path order
+func diffObjects(prefix string, old, new map[string]interface{}) []FieldChange {
+	keys := make([]string, 0, len(old)+len(new))
+	for key := range old {
+		keys = append(keys, key)
+	}
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []FieldChange
+	for _, key := range keys {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		oldValue, newValue := old[key], new[key]
+		oldObject, oldIsObject := oldValue.(map[string]interface{})
+		newObject, newIsObject := newValue.(map[string]interface{})
+		if oldIsObject && newIsObject {
+			changes = append(changes, diffObjects(path, oldObject, newObject)...)
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, FieldChange{Field: path, Old: oldValue, New: newValue})
+		}
+	}
+	return changes
+}
+
+// asObject returns a decoded JSON value as an object, or an empty object if it
+// is not one
+func asObject(value interface{}) map[string]interface{} {
+	if object, ok := value.(map[string]interface{}); ok {
+		return object
+	}
+	return map[string]interface{}{}
+}
+
+// diffValue renders a field value for a text diff
+func diffValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,258 @@
+package astra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Entity Schemas
+// ============================================================================
+
+// EntitySchemaResolver loads the JSON Schema that an Entity's SchemaURL refers
+// to. Implementations must be safe for concurrent use.
+type EntitySchemaResolver interface {
+	ResolveSchema(url string) (Schema, error)
+}
+
+// ContextSchemaResolver is implemented by EntitySchemaResolvers whose lookups
+// can be cancelled. ValidateEntityValueContext uses it when available.
+type ContextSchemaResolver interface {
+	ResolveSchemaContext(ctx context.Context, url string) (Schema, error)
+}
+
+// ValidateEntityValue validates a value for one field of an entity against the
+// schema at the entity's SchemaURL, loaded through r. The field is looked up
+// among the schema's top-level properties and checked with the same rules as
+// the embedded ASTRA schemas; local $refs into the schema's definitions or
+// $defs are followed. A field the schema does not describe is accepted unless
+// the schema sets additionalProperties to false. An entity without a
+// SchemaURL has nothing to validate against, and any value is accepted.
+func ValidateEntityValue(r EntitySchemaResolver, e Entity, field string, value interface{}) error {
+	return ValidateEntityValueContext(context.Background(), r, e, field, value)
+}
+
+// ValidateEntityValueContext is like ValidateEntityValue, but loads the schema
+// with ctx when r implements ContextSchemaResolver, so that a slow fetch is
+// abandoned once ctx is done. Other resolvers are not called once ctx is done.
+func ValidateEntityValueContext(ctx context.Context, r EntitySchemaResolver, e Entity, field string, value interface{}) error {
+	if e.SchemaURL == nil || *e.SchemaURL == "" {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var schema Schema
+	var err error
+	if cr, ok := r.(ContextSchemaResolver); ok {
+		schema, err = cr.ResolveSchemaContext(ctx, *e.SchemaURL)
+	} else {
+		schema, err = r.ResolveSchema(*e.SchemaURL)
+	}
+	if err != nil {
+		return fmt.Errorf("entity %s: %w", e.ID, err)
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	propSchema, ok := properties[field].(map[string]interface{})
+	if !ok {
+		if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+			return ValidationError{Field: field, Message: fmt.Sprintf("field is not defined by entity schema %s", *e.SchemaURL), Value: value}
+		}
+		return nil
+	}
+
+	// Compare values as decoded JSON, so that Go integers, structs and the
+	// like match the schema the same way they would once marshaled
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("entity %s: cannot encode value for field %s: %w", e.ID, field, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("entity %s: cannot encode value for field %s: %w", e.ID, field, err)
+	}
+
+	if err := newEntitySchemaValidator(schema).validateProperty(decoded, propSchema); err != nil {
+		return ValidationError{Field: field, Message: err.Error(), Value: value}
+	}
+	return nil
+}
+
+// newEntitySchemaValidator returns a validator whose $ref table holds the
+// definitions of an entity schema rather than the embedded ASTRA schemas
+func newEntitySchemaValidator(schema Schema) *schemaValidator {
+	return &schemaValidator{
+		patterns: make(map[string]*regexp.Regexp),
+		refs:     documentRefs(schema),
+	}
+}
+
+// normalizeEntitySchema rewrites the required lists of a decoded schema from
+// []interface{} to []string, the form the validator expects
+func normalizeEntitySchema(node interface{}) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, child := range n {
+			if list, ok := child.([]interface{}); ok && key == "required" {
+				required := make([]string, 0, len(list))
+				for _, item := range list {
+					if name, ok := item.(string); ok {
+						required = append(required, name)
+					}
+				}
+				n[key] = required
+				continue
+			}
+			normalizeEntitySchema(child)
+		}
+	case []interface{}:
+		for _, child := range n {
+			normalizeEntitySchema(child)
+		}
+	}
+}
+
+// ============================================================================
+// HTTP Schema Resolver
+// ============================================================================
+
+const (
+	// DefaultSchemaCacheTTL is how long HTTPSchemaResolver reuses a fetched schema
+	DefaultSchemaCacheTTL = 5 * time.Minute
+	// maxEntitySchemaBytes bounds the size of a fetched schema document
+	maxEntitySchemaBytes = 4 << 20
+)
+
+// HTTPSchemaResolver is an EntitySchemaResolver that fetches schemas over
+// HTTP(S) and caches them by URL. A cached schema is reused until its TTL has
+// passed and is then fetched again; failed fetches are not cached.
+type HTTPSchemaResolver struct {
+	client *http.Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedEntitySchema
+}
+
+// cachedEntitySchema is a schema held by HTTPSchemaResolver with its expiry
+type cachedEntitySchema struct {
+	schema  Schema
+	expires time.Time
+}
+
+// HTTPSchemaResolverOption is a function type for configuring HTTPSchemaResolver creation
+type HTTPSchemaResolverOption func(*HTTPSchemaResolver)
+
+// WithSchemaHTTPClient sets the client used to fetch schemas
+func WithSchemaHTTPClient(client *http.Client) HTTPSchemaResolverOption {
+	return func(r *HTTPSchemaResolver) {
+		if client != nil {
+			r.client = client
+		}
+	}
+}
+
+// WithSchemaCacheTTL sets how long a fetched schema is reused. A TTL of zero
+// or less disables caching.
+func WithSchemaCacheTTL(ttl time.Duration) HTTPSchemaResolverOption {
+	return func(r *HTTPSchemaResolver) {
+		r.ttl = ttl
+	}
+}
+
+// NewHTTPSchemaResolver creates an HTTPSchemaResolver. By default schemas are
+// fetched with a client that times out after 10 seconds and cached for
+// DefaultSchemaCacheTTL.
+func NewHTTPSchemaResolver(options ...HTTPSchemaResolverOption) *HTTPSchemaResolver {
+	r := &HTTPSchemaResolver{
+		client: &http.Client{Timeout: 10 * time.Second},
+		ttl:    DefaultSchemaCacheTTL,
+		now:    time.Now,
+		cache:  make(map[string]cachedEntitySchema),
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(r)
+	}
+
+	return r
+}
+
+// ResolveSchema returns the schema at url, from the cache if it has not
+// expired. The response must be a 200 with a JSON object body.
+func (r *HTTPSchemaResolver) ResolveSchema(url string) (Schema, error) {
+	return r.ResolveSchemaContext(context.Background(), url)
+}
+
+// ResolveSchemaContext is like ResolveSchema, but a fetch is made with ctx and
+// is abandoned once ctx is done. Cached schemas are returned regardless.
+func (r *HTTPSchemaResolver) ResolveSchemaContext(ctx context.Context, url string) (Schema, error) {
+	r.mu.Lock()
+	cached, ok := r.cache[url]
+	r.mu.Unlock()
+	if ok && r.now().Before(cached.expires) {
+		return cached.schema, nil
+	}
+
+	schema, err := r.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.ttl > 0 {
+		r.mu.Lock()
+		r.cache[url] = cachedEntitySchema{schema: schema, expires: r.now().Add(r.ttl)}
+		r.mu.Unlock()
+	}
+	return schema, nil
+}
+
+// fetch downloads and decodes the schema at url
+func (r *HTTPSchemaResolver) fetch(ctx context.Context, url string) (Schema, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("unsupported schema URL %s: only http and https are supported", url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %s: %w", url, err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch schema %s: %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxEntitySchemaBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %s: %w", url, err)
+	}
+	if len(data) > maxEntitySchemaBytes {
+		return nil, fmt.Errorf("schema %s exceeds %d bytes", url, maxEntitySchemaBytes)
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema %s: %w", url, err)
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("invalid schema %s: expected a JSON object", url)
+	}
+	normalizeEntitySchema(map[string]interface{}(schema))
+	return schema, nil
+}
@@ -0,0 +1,216 @@
+package astra
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ============================================================================
+// Enum Parsing
+// ============================================================================
+
+// InvalidEnumValueError is returned by the Parse functions when a string is not
+// one of an enum's values
+type InvalidEnumValueError struct {
+	// Enum names the type being parsed, such as "act type"
+	Enum  string
+	Value string
+}
+
+func (e InvalidEnumValueError) Error() string {
+	return fmt.Sprintf("invalid %s: %q", e.Enum, e.Value)
+}
+
+// The values of each string enum, in declaration order
+var (
+	actTypes            = []ActType{ActTypeAsk, ActTypeFact, ActTypeConfirm, ActTypeCommit, ActTypeError}
+	builtinSources      = []Source{SourceHuman, SourceSpeechRecognition, SourceTextAnalysis, SourceSystem, SourceAI}
+	constraintTypes     = []ConstraintType{ConstraintTypeRequired, ConstraintTypeOptional, ConstraintTypeMinLength, ConstraintTypeMaxLength, ConstraintTypePattern, ConstraintTypeFormat, ConstraintTypeRange, ConstraintTypeEnum, ConstraintTypeCustom}
+	formatTypes         = []FormatType{FormatTypeEmail, FormatTypePhone, FormatTypeURL, FormatTypeDate, FormatTypeTime, FormatTypeDateTime, FormatTypeUUID, FormatTypeIPv4, FormatTypeIPv6}
+	participantTypes    = []ParticipantType{ParticipantTypeHuman, ParticipantTypeAI, ParticipantTypeSystem, ParticipantTypeBot}
+	expectedTypes       = []ExpectedType{ExpectedTypeString, ExpectedTypeNumber, ExpectedTypeBoolean, ExpectedTypeObject, ExpectedTypeArray, ExpectedTypeDate, ExpectedTypeEmail, ExpectedTypePhone, ExpectedTypeAddress}
+	fieldOperations     = []FieldOperation{FieldOperationSet, FieldOperationAppend, FieldOperationIncrement, FieldOperationDecrement, FieldOperationDelete, FieldOperationMerge}
+	validationStatuses  = []ValidationStatus{ValidationStatusPending, ValidationStatusValid, ValidationStatusInvalid, ValidationStatusPartial}
+	confirmationMethods = []ConfirmationMethod{ConfirmationMethodVerbal, ConfirmationMethodExplicit, ConfirmationMethodImplicit, ConfirmationMethodTimeout, ConfirmationMethodSystem}
+	commitActions       = []CommitAction{CommitActionCreate, CommitActionUpdate, CommitActionDelete, CommitActionExecute, CommitActionCancel, CommitActionPause, CommitActionResume}
+	commitStatuses      = []CommitStatus{CommitStatusPending, CommitStatusInProgress, CommitStatusSuccess, CommitStatusFailed, CommitStatusRetrying, CommitStatusCancelled}
+	errorSeverities     = []ErrorSeverity{ErrorSeverityInfo, ErrorSeverityWarning, ErrorSeverityError, ErrorSeverityCritical}
+	errorCategories     = []ErrorCategory{ErrorCategoryValidation, ErrorCategoryProcessing, ErrorCategoryIntegration, ErrorCategoryTimeout, ErrorCategoryPermission, ErrorCategorySystem, ErrorCategoryUserInput, ErrorCategoryBusinessRule}
+	suggestedActions    = []SuggestedAction{SuggestedActionRetry, SuggestedActionEscalate, SuggestedActionIgnore, SuggestedActionClarify, SuggestedActionFallback, SuggestedActionTerminate}
+	conversationStatus  = []ConversationStatus{ConversationStatusActive, ConversationStatusPaused, ConversationStatusCompleted, ConversationStatusFailed, ConversationStatusCancelled}
+)
+
+// parseEnum matches a string exactly against an enum's values
+func parseEnum[T ~string](enum, value string, values []T) (T, error) {
+	if slices.Contains(values, T(value)) {
+		return T(value), nil
+	}
+	return "", InvalidEnumValueError{Enum: enum, Value: value}
+}
+
+// ParseActType parses an act type, returning an InvalidEnumValueError for unknown values
+func ParseActType(s string) (ActType, error) {
+	return parseEnum("act type", s, actTypes)
+}
+
+// ValidActTypes returns every act type
+func ValidActTypes() []ActType {
+	return slices.Clone(actTypes)
+}
+
+// ParseSource parses an act source, accepting the built-in sources and any
+// registered with RegisterSource. An InvalidEnumValueError is returned for
+// unknown values.
+func ParseSource(s string) (Source, error) {
+	if !isValidSource(Source(s)) {
+		return "", InvalidEnumValueError{Enum: "source", Value: s}
+	}
+	return Source(s), nil
+}
+
+// ValidSources returns the built-in sources followed by any registered with
+// RegisterSource, in sorted order
+func ValidSources() []Source {
+	customSourcesMu.RLock()
+	custom := make([]Source, 0, len(customSources))
+	for source := range customSources {
+		if !slices.Contains(builtinSources, source) {
+			custom = append(custom, source)
+		}
+	}
+	customSourcesMu.RUnlock()
+
+	sort.Slice(custom, func(i, j int) bool { return custom[i] < custom[j] })
+	return append(slices.Clone(builtinSources), custom...)
+}
+
+// ParseConstraintType parses a constraint type, returning an InvalidEnumValueError for unknown values
+func ParseConstraintType(s string) (ConstraintType, error) {
+	return parseEnum("constraint type", s, constraintTypes)
+}
+
+// ValidConstraintTypes returns every constraint type
+func ValidConstraintTypes() []ConstraintType {
+	return slices.Clone(constraintTypes)
+}
+
+// ParseFormatType parses a format type, returning an InvalidEnumValueError for unknown values
+func ParseFormatType(s string) (FormatType, error) {
+	return parseEnum("format type", s, formatTypes)
+}
+
+// ValidFormatTypes returns every format type
+func ValidFormatTypes() []FormatType {
+	return slices.Clone(formatTypes)
+}
+
+// ParseParticipantType parses a participant type, returning an InvalidEnumValueError for unknown values
+func ParseParticipantType(s string) (ParticipantType, error) {
+	return parseEnum("participant type", s, participantTypes)
+}
+
+// ValidParticipantTypes returns every participant type
+func ValidParticipantTypes() []ParticipantType {
+	return slices.Clone(participantTypes)
+}
+
+// ParseExpectedType parses an expected type, returning an InvalidEnumValueError for unknown values
+func ParseExpectedType(s string) (ExpectedType, error) {
+	return parseEnum("expected type", s, expectedTypes)
+}
+
+// ValidExpectedTypes returns every expected type
+func ValidExpectedTypes() []ExpectedType {
+	return slices.Clone(expectedTypes)
+}
+
+// ParseFieldOperation parses a field operation, returning an InvalidEnumValueError for unknown values
+func ParseFieldOperation(s string) (FieldOperation, error) {
+	return parseEnum("field operation", s, fieldOperations)
+}
+
+// ValidFieldOperations returns every field operation
+func ValidFieldOperations() []FieldOperation {
+	return slices.Clone(fieldOperations)
+}
+
+// ParseValidationStatus parses a validation status, returning an InvalidEnumValueError for unknown values
+func ParseValidationStatus(s string) (ValidationStatus, error) {
+	return parseEnum("validation status", s, validationStatuses)
+}
+
+// ValidValidationStatuses returns every validation status
+func ValidValidationStatuses() []ValidationStatus {
+	return slices.Clone(validationStatuses)
+}
+
+// ParseConfirmationMethod parses a confirmation method, returning an InvalidEnumValueError for unknown values
+func ParseConfirmationMethod(s string) (ConfirmationMethod, error) {
+	return parseEnum("confirmation method", s, confirmationMethods)
+}
+
+// ValidConfirmationMethods returns every confirmation method
+func ValidConfirmationMethods() []ConfirmationMethod {
+	return slices.Clone(confirmationMethods)
+}
+
+// ParseCommitAction parses a commit action, returning an InvalidEnumValueError for unknown values
+func ParseCommitAction(s string) (CommitAction, error) {
+	return parseEnum("commit action", s, commitActions)
+}
+
+// ValidCommitActions returns every commit action
+func ValidCommitActions() []CommitAction {
+	return slices.Clone(commitActions)
+}
+
+// ParseCommitStatus parses a commit status, returning an InvalidEnumValueError for unknown values
+func ParseCommitStatus(s string) (CommitStatus, error) {
+	return parseEnum("commit status", s, commitStatuses)
+}
+
+// ValidCommitStatuses returns every commit status
+func ValidCommitStatuses() []CommitStatus {
+	return slices.Clone(commitStatuses)
+}
+
+// ParseErrorSeverity parses an error severity, returning an InvalidEnumValueError for unknown values
+func ParseErrorSeverity(s string) (ErrorSeverity, error) {
+	return parseEnum("error severity", s, errorSeverities)
+}
+
+// ValidErrorSeverities returns every error severity, from least to most severe
+func ValidErrorSeverities() []ErrorSeverity {
+	return slices.Clone(errorSeverities)
+}
+
+// ParseErrorCategory parses an error category, returning an InvalidEnumValueError for unknown values
+func ParseErrorCategory(s string) (ErrorCategory, error) {
+	return parseEnum("error category", s, errorCategories)
+}
+
+// ValidErrorCategories returns every error category
+func ValidErrorCategories() []ErrorCategory {
+	return slices.Clone(errorCategories)
+}
+
+// ParseSuggestedAction parses a suggested action, returning an InvalidEnumValueError for unknown values
+func ParseSuggestedAction(s string) (SuggestedAction, error) {
+	return parseEnum("suggested action", s, suggestedActions)
+}
+
+// ValidSuggestedActions returns every suggested action
+func ValidSuggestedActions() []SuggestedAction {
+	return slices.Clone(suggestedActions)
+}
+
+// ParseConversationStatus parses a conversation status, returning an InvalidEnumValueError for unknown values
+func ParseConversationStatus(s string) (ConversationStatus, error) {
+	return parseEnum("conversation status", s, conversationStatus)
+}
+
+// ValidConversationStatuses returns every conversation status
+func ValidConversationStatuses() []ConversationStatus {
+	return slices.Clone(conversationStatus)
+}
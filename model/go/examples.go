@@ -0,0 +1,136 @@
+package astra
+
+import "time"
+
+// ============================================================================
+// Documentation Examples
+// ============================================================================
+
+// exampleTimestamp is the fixed timestamp used by all example acts so that
+// generated documentation is stable between runs
+var exampleTimestamp = time.Date(2025, time.January, 15, 14, 30, 0, 0, time.UTC)
+
+// ExampleAct returns a fully-populated example act of the given type for use in
+// generated documentation and tests. Every optional field is set to a value that
+// is consistent with the rest of the act, and the result passes both ValidateAct
+// and ValidateJSON. IDs and timestamps are fixed so the output is deterministic.
+// It returns nil for an unknown act type.
+func ExampleAct(actType ActType) ConversationAct {
+	switch actType {
+	case ActTypeAsk:
+		required := true
+		expectedType := ExpectedTypeEmail
+		retryCount := 0
+		maxRetries := 3
+		return Ask{
+			Act:          exampleBaseAct("act_example_ask", "agent_123", ActTypeAsk, SourceAI, "What's your email address?"),
+			Field:        "email",
+			Prompt:       "What's your email address?",
+			Constraints:  []Constraint{RequiredConstraint(), EmailFormatConstraint()},
+			Required:     &required,
+			ExpectedType: &expectedType,
+			RetryCount:   &retryCount,
+			MaxRetries:   &maxRetries,
+		}
+	case ActTypeFact:
+		operation := FieldOperationSet
+		validationStatus := ValidationStatusValid
+		return Fact{
+			Act:              exampleBaseAct("act_example_fact", "customer_456", ActTypeFact, SourceSpeechRecognition, "It's john at example dot com"),
+			Entity:           NewEntity("customer_456", "customer", WithExternalID("CRM-0042"), WithEntitySystem("crm")),
+			Field:            "email",
+			Value:            "john@example.com",
+			Operation:        &operation,
+			PreviousValue:    "j.doe@example.com",
+			ValidationStatus: &validationStatus,
+		}
+	case ActTypeConfirm:
+		awaiting := false
+		confirmed := true
+		method := ConfirmationMethodExplicit
+		timeoutMs := int64(30000)
+		return Confirm{
+			Act:                exampleBaseAct("act_example_confirm", "agent_123", ActTypeConfirm, SourceAI, "Your email is john@example.com, is that correct?"),
+			Entity:             "customer_456",
+			Summary:            "Your email is john@example.com, is that correct?",
+			Awaiting:           &awaiting,
+			Confirmed:          &confirmed,
+			ConfirmationMethod: &method,
+			FieldsConfirmed:    []string{"email"},
+			TimeoutMs:          &timeoutMs,
+		}
+	case ActTypeCommit:
+		system := "customer_management"
+		transactionID := "txn_98765"
+		status := CommitStatusSuccess
+		retryCount := 0
+		maxRetries := 3
+		idempotencyKey := "customer_456_email_update"
+		return Commit{
+			Act:            exampleBaseAct("act_example_commit", "system_001", ActTypeCommit, SourceSystem, ""),
+			Entity:         "customer_456",
+			Action:         CommitActionUpdate,
+			System:         &system,
+			TransactionID:  &transactionID,
+			Status:         &status,
+			RetryCount:     &retryCount,
+			MaxRetries:     &maxRetries,
+			IdempotencyKey: &idempotencyKey,
+			RollbackInfo: map[string]interface{}{
+				"previous_email": "j.doe@example.com",
+			},
+		}
+	case ActTypeError:
+		severity := ErrorSeverityWarning
+		category := ErrorCategoryValidation
+		relatedActID := "act_example_fact"
+		suggestedAction := SuggestedActionClarify
+		userMessage := "Sorry, I didn't catch that email address. Could you repeat it?"
+		stackTrace := "validation.go:42 validateEmail"
+		return Error{
+			Act:         exampleBaseAct("act_example_error", "system_001", ActTypeError, SourceSystem, ""),
+			Code:        "VALIDATION_ERROR",
+			Message:     "Invalid email format",
+			Recoverable: true,
+			Severity:    &severity,
+			Category:    &category,
+			Details: map[string]interface{}{
+				"field": "email",
+				"value": "john at example",
+			},
+			RelatedActID:    &relatedActID,
+			SuggestedAction: &suggestedAction,
+			UserMessage:     &userMessage,
+			StackTrace:      &stackTrace,
+		}
+	default:
+		return nil
+	}
+}
+
+// exampleBaseAct builds the base Act shared by the example acts
+func exampleBaseAct(id, speaker string, actType ActType, source Source, originalText string) Act {
+	confidence := 0.95
+	channel := "voice"
+	language := "en-US"
+	processingTimeMs := 120.0
+
+	metadata := &ActMetadata{
+		Channel:          &channel,
+		Language:         &language,
+		ProcessingTimeMs: &processingTimeMs,
+	}
+	if originalText != "" {
+		metadata.OriginalText = &originalText
+	}
+
+	return Act{
+		ID:         id,
+		Timestamp:  exampleTimestamp,
+		Speaker:    speaker,
+		Type:       actType,
+		Confidence: &confidence,
+		Source:     &source,
+		Metadata:   metadata,
+	}
+}
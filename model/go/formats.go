@@ -0,0 +1,96 @@
+package astra
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Format Validation
+// ============================================================================
+
+// emailPattern is the regex pattern for email addresses: an RFC 5322 dot-atom
+// local part and a domain whose top-level label is at least two letters
+var emailPattern = regexp.MustCompile("^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+(\\.[a-zA-Z0-9!#$%&'*+/=?^_`{|}~-]+)*" +
+	`@([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`)
+
+// e164Pattern is the regex pattern for E.164 phone numbers
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// uuidPattern is the regex pattern for UUIDs in canonical textual form
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// phoneSeparators are the formatting characters NormalizePhone strips
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// IsValidEmail checks if a string is a valid email address
+func IsValidEmail(email string) bool {
+	return len(email) <= 254 && emailPattern.MatchString(email)
+}
+
+// IsValidPhoneE164 checks if a string is a phone number in strict E.164 form
+// (e.g. +14155552671). Formatted numbers must go through NormalizePhone first.
+func IsValidPhoneE164(phone string) bool {
+	return e164Pattern.MatchString(phone)
+}
+
+// NormalizePhone converts a formatted phone number to E.164. Spaces, dashes,
+// dots, and parentheses are removed and a leading 00 international prefix is
+// replaced with +. Numbers without a country code are only accepted as
+// 10-digit North American numbers, which are given the +1 prefix.
+func NormalizePhone(phone string) (string, error) {
+	normalized := phoneSeparators.Replace(strings.TrimSpace(phone))
+
+	switch {
+	case strings.HasPrefix(normalized, "+"):
+	case strings.HasPrefix(normalized, "00"):
+		normalized = "+" + normalized[2:]
+	case len(normalized) == 10:
+		normalized = "+1" + normalized
+	case len(normalized) == 11 && strings.HasPrefix(normalized, "1"):
+		normalized = "+" + normalized
+	default:
+		return "", fmt.Errorf("phone number must include a country code: %s", phone)
+	}
+
+	if !IsValidPhoneE164(normalized) {
+		return "", fmt.Errorf("invalid phone number: %s", phone)
+	}
+	return normalized, nil
+}
+
+// isValidFormat checks a string against a constraint format
+func isValidFormat(format FormatType, value string) bool {
+	switch format {
+	case FormatTypeEmail:
+		return IsValidEmail(value)
+	case FormatTypePhone:
+		return IsValidPhoneE164(value)
+	case FormatTypeURL:
+		u, err := url.Parse(value)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	case FormatTypeDate:
+		_, err := time.Parse("2006-01-02", value)
+		return err == nil
+	case FormatTypeTime:
+		_, err := time.Parse("15:04:05", value)
+		return err == nil
+	case FormatTypeDateTime:
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case FormatTypeUUID:
+		return uuidPattern.MatchString(value)
+	case FormatTypeIPv4:
+		ip := net.ParseIP(value)
+		return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+	case FormatTypeIPv6:
+		ip := net.ParseIP(value)
+		return ip != nil && strings.Contains(value, ":")
+	default:
+		return false
+	}
+}
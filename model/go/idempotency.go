@@ -0,0 +1,59 @@
+package astra
+
+// ============================================================================
+// Commit Idempotency
+// ============================================================================
+
+// commitIdempotencyKey returns a commit's idempotency key, or "" if the act is
+// not a commit or has no key
+func commitIdempotencyKey(act ConversationAct) string {
+	if commit, ok := act.(Commit); ok && commit.IdempotencyKey != nil {
+		return *commit.IdempotencyKey
+	}
+	return ""
+}
+
+// DuplicateIdempotencyKeys returns, for each idempotency key shared by more
+// than one commit, the IDs of those commits in conversation order. Commits
+// without a key are never considered duplicates.
+func (c *Conversation) DuplicateIdempotencyKeys() map[string][]string {
+	byKey := make(map[string][]string)
+	for _, act := range c.Acts {
+		if key := commitIdempotencyKey(act); key != "" {
+			byKey[key] = append(byKey[key], act.GetAct().ID)
+		}
+	}
+
+	duplicates := make(map[string][]string)
+	for key, ids := range byKey {
+		if len(ids) > 1 {
+			duplicates[key] = ids
+		}
+	}
+	return duplicates
+}
+
+// DedupeCommits returns a copy of the conversation keeping only the first
+// commit for each idempotency key, so that a replayed log cannot execute the
+// same operation twice. Commits without a key and all other acts are kept. The
+// copy's metadata counts are recomputed; the original is left untouched.
+func (c *Conversation) DedupeCommits() Conversation {
+	deduped := c.Clone()
+	seen := make(map[string]struct{})
+	acts := make([]ConversationAct, 0, len(deduped.Acts))
+	for _, act := range deduped.Acts {
+		if key := commitIdempotencyKey(act); key != "" {
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		acts = append(acts, act)
+	}
+	deduped.Acts = acts
+
+	if deduped.Metadata != nil {
+		deduped.updateMetadata()
+	}
+	return deduped
+}
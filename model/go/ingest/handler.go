@@ -0,0 +1,137 @@
+// Package ingest provides an HTTP handler that decodes, validates, and stores
+// ASTRA conversations posted as JSON.
+package ingest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	astra "github.com/pryszm/astra-model-go"
+)
+
+// DefaultMaxBodyBytes is the largest request body accepted unless overridden
+// with WithMaxBodyBytes
+const DefaultMaxBodyBytes int64 = 10 << 20
+
+// ErrorResponse is the JSON body written when a request is rejected
+type ErrorResponse struct {
+	// Summary of why the request was rejected
+	Error string `json:"error"`
+	// Every individual problem found, such as each validation failure
+	Details []string `json:"details,omitempty"`
+}
+
+// IngestResponse is the JSON body written when a conversation is stored
+type IngestResponse struct {
+	// ID of the stored conversation
+	ID string `json:"id"`
+}
+
+// HandlerOption is a function type for configuring the ingest handler
+type HandlerOption func(*handler)
+
+// WithMaxBodyBytes sets the largest request body the handler accepts. Larger
+// bodies are rejected with 413 Request Entity Too Large.
+func WithMaxBodyBytes(maxBytes int64) HandlerOption {
+	return func(h *handler) {
+		if maxBytes > 0 {
+			h.maxBodyBytes = maxBytes
+		}
+	}
+}
+
+// handler implements http.Handler for conversation ingestion
+type handler struct {
+	store        func(astra.Conversation) error
+	maxBodyBytes int64
+}
+
+// NewIngestHandler returns a handler that accepts a conversation as a JSON POST
+// body, validates it with astra.UnmarshalConversationContext under the
+// request's context, and passes it to store.
+// Responses are:
+//
+//	201 Created                   the conversation was stored; the body holds its ID
+//	400 Bad Request               the body is not a valid conversation; every failure is listed
+//	405 Method Not Allowed        the request is not a POST
+//	413 Request Entity Too Large  the body exceeds the maximum size
+//	415 Unsupported Media Type    the Content-Type is not application/json
+//	500 Internal Server Error     store returned an error
+//
+// Error responses carry an ErrorResponse JSON body.
+func NewIngestHandler(store func(astra.Conversation) error, options ...HandlerOption) http.Handler {
+	h := &handler{
+		store:        store,
+		maxBodyBytes: DefaultMaxBodyBytes,
+	}
+	for _, option := range options {
+		option(h)
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, ErrorResponse{Error: "method not allowed"})
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		writeJSON(w, http.StatusUnsupportedMediaType, ErrorResponse{Error: "content type must be application/json"})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, ErrorResponse{Error: "request body too large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "failed to read request body"})
+		return
+	}
+
+	conversation, err := astra.UnmarshalConversationContext(r.Context(), data, true)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{
+			Error:   "invalid conversation",
+			Details: errorDetails(err),
+		})
+		return
+	}
+
+	if err := h.store(conversation); err != nil {
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "failed to store conversation"})
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, IngestResponse{ID: conversation.ID})
+}
+
+// errorDetails flattens joined errors into one message per failure
+func errorDetails(err error) []string {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+
+	var details []string
+	for _, e := range joined.Unwrap() {
+		details = append(details, errorDetails(e)...)
+	}
+	return details
+}
+
+// writeJSON writes a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
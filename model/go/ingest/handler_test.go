@@ -0,0 +1,116 @@
+package ingest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	astra "github.com/pryszm/astra-model-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newConversationJSON(t *testing.T) string {
+	t.Helper()
+	conv := astra.NewConversation([]astra.Participant{
+		astra.NewParticipant("agent_123", astra.ParticipantTypeAI),
+	})
+	require.NoError(t, conv.AddAct(astra.NewAsk("agent_123", "email", "What's your email?")))
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func post(h http.Handler, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/conversations", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIngestHandlerStoresConversation(t *testing.T) {
+	var stored []astra.Conversation
+	h := NewIngestHandler(func(c astra.Conversation) error {
+		stored = append(stored, c)
+		return nil
+	})
+
+	rec := post(h, "application/json; charset=utf-8", newConversationJSON(t))
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Len(t, stored, 1)
+	assert.Len(t, stored[0].Acts, 1)
+
+	var resp IngestResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, stored[0].ID, resp.ID)
+}
+
+func TestIngestHandlerRejectsInvalidRequests(t *testing.T) {
+	stored := 0
+	h := NewIngestHandler(func(astra.Conversation) error {
+		stored++
+		return nil
+	}, WithMaxBodyBytes(64))
+
+	rec := post(h, "text/plain", "{}")
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	rec = post(h, "application/json", newConversationJSON(t))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/conversations", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+
+	assert.Zero(t, stored)
+}
+
+func TestIngestHandlerListsValidationFailures(t *testing.T) {
+	h := NewIngestHandler(func(astra.Conversation) error {
+		t.Fatal("invalid conversations must not be stored")
+		return nil
+	})
+
+	// Two acts by a speaker who is not a participant, one of them also invalid
+	body := `{
+		"id": "conv_123",
+		"participants": [{"id": "agent_123", "type": "ai"}],
+		"acts": [
+			{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "ask", "field": "email", "prompt": "Email?"},
+			{"id": "act_2", "timestamp": "2025-01-15T14:31:00Z", "speaker": "customer_456", "type": "ask", "field": "", "prompt": "Email?"}
+		]
+	}`
+	rec := post(h, "application/json", body)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "invalid conversation", resp.Error)
+	require.GreaterOrEqual(t, len(resp.Details), 2)
+	assert.Contains(t, strings.Join(resp.Details, "\n"), "act 0 (act_1)")
+	assert.Contains(t, strings.Join(resp.Details, "\n"), "act 1")
+
+	rec = post(h, "application/json", "{not json")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestIngestHandlerStoreFailure(t *testing.T) {
+	h := NewIngestHandler(func(astra.Conversation) error {
+		return errors.New("database unavailable")
+	})
+
+	rec := post(h, "application/json", newConversationJSON(t))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "failed to store conversation", resp.Error)
+	assert.NotContains(t, rec.Body.String(), "database unavailable")
+}
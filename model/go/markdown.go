@@ -0,0 +1,143 @@
+package astra
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Markdown Rendering
+// ============================================================================
+
+// actLabels are the headings used for each act type in Markdown output
+var actLabels = map[ActType]string{
+	ActTypeAsk:     "❓ Ask",
+	ActTypeFact:    "📝 Fact",
+	ActTypeConfirm: "✅ Confirm",
+	ActTypeCommit:  "💾 Commit",
+	ActTypeError:   "⚠️ Error",
+}
+
+// markdownCellEscaper escapes characters that would break a Markdown table cell
+var markdownCellEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+// Markdown renders the conversation as a Markdown report with a participants
+// table, the acts in order, and the final state computed by ComputeFinalState.
+// Errors are rendered as callouts. Output is deterministic for a given
+// conversation, so it can be used in snapshot tests.
+func (c Conversation) Markdown() string {
+	location := c.contextLocation()
+	formatTime := func(t time.Time) string {
+		if location != nil {
+			t = t.In(location)
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Conversation %s\n\n", c.ID)
+	if c.Status != nil {
+		fmt.Fprintf(&b, "- **Status:** %s\n", *c.Status)
+	}
+	if c.Channel != nil {
+		fmt.Fprintf(&b, "- **Channel:** %s\n", *c.Channel)
+	}
+	if c.StartedAt != nil {
+		fmt.Fprintf(&b, "- **Started:** %s\n", formatTime(*c.StartedAt))
+	}
+	if c.EndedAt != nil {
+		fmt.Fprintf(&b, "- **Ended:** %s\n", formatTime(*c.EndedAt))
+	}
+	fmt.Fprintf(&b, "- **Acts:** %d\n", len(c.Acts))
+
+	b.WriteString("\n## Participants\n\n")
+	b.WriteString("| ID | Type | Role | Name |\n| --- | --- | --- | --- |\n")
+	for _, participant := range c.Participants {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+			markdownCell(participant.ID),
+			markdownCell(string(participant.Type)),
+			markdownCell(stringOrEmpty(participant.Role)),
+			markdownCell(stringOrEmpty(participant.Name)))
+	}
+
+	b.WriteString("\n## Acts\n\n")
+	if len(c.Acts) == 0 {
+		b.WriteString("_No acts._\n")
+	}
+	for i, act := range c.Acts {
+		baseAct := act.GetAct()
+		label, ok := actLabels[act.GetType()]
+		if !ok {
+			label = string(act.GetType())
+		}
+		fmt.Fprintf(&b, "- `%s` **%s** %s", formatTime(baseAct.Timestamp), baseAct.Speaker, label)
+
+		if errorAct, ok := act.(Error); ok {
+			fmt.Fprintf(&b, "\n\n  > [!%s]\n  > **%s** - %s\n", errorCallout(errorAct.Severity), errorAct.Code, errorAct.Message)
+			if errorAct.RelatedActID != nil {
+				fmt.Fprintf(&b, "  > Related act: `%s`\n", *errorAct.RelatedActID)
+			}
+			if i < len(c.Acts)-1 {
+				b.WriteString("\n")
+			}
+			continue
+		}
+		fmt.Fprintf(&b, ": %s\n", describeAct(act))
+	}
+
+	b.WriteString("\n## Final State\n")
+	state := c.ComputeFinalState()
+	if len(state) == 0 {
+		b.WriteString("\n_No facts recorded._\n")
+	}
+	for _, entityID := range sortedKeys(state) {
+		fmt.Fprintf(&b, "\n### %s\n\n", entityID)
+		b.WriteString("| Field | Value |\n| --- | --- |\n")
+		entityState, _ := state[entityID].(map[string]interface{})
+		for _, field := range sortedKeys(entityState) {
+			fmt.Fprintf(&b, "| %s | %s |\n", markdownCell(field), markdownCell(transcriptValue(entityState[field])))
+		}
+	}
+
+	return b.String()
+}
+
+// errorCallout returns the Markdown alert type for an error severity
+func errorCallout(severity *ErrorSeverity) string {
+	if severity == nil {
+		return "CAUTION"
+	}
+	switch *severity {
+	case ErrorSeverityInfo:
+		return "NOTE"
+	case ErrorSeverityWarning:
+		return "WARNING"
+	default:
+		return "CAUTION"
+	}
+}
+
+// markdownCell escapes a value for use in a Markdown table cell
+func markdownCell(value string) string {
+	return markdownCellEscaper.Replace(value)
+}
+
+// stringOrEmpty dereferences an optional string
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
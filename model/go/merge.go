@@ -0,0 +1,419 @@
+package astra
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Conversation Merging
+// ============================================================================
+
+// MergeOption configures how MergeConversations combines two conversations
+type MergeOption func(*mergePolicy)
+
+// mergePolicy holds the options applied by MergeConversations
+type mergePolicy struct {
+	dedupeActs bool
+}
+
+// DedupeActs sets whether an act ID that appears in both conversations is kept
+// once rather than treated as an error. When deduplicating, the copy from the
+// first conversation wins. This suits partial logs of the same session that
+// overlap; by default duplicate act IDs are an error.
+func DedupeActs(dedupe bool) MergeOption {
+	return func(p *mergePolicy) {
+		p.dedupeActs = dedupe
+	}
+}
+
+// MergeConversations combines two conversations, such as the legs of a
+// transferred call or partial logs of one session, into a new conversation.
+//
+// Participants are unioned by ID; a participant defined differently in the two
+// conversations is an error. Acts from both are ordered by timestamp, with ties
+// keeping a's acts before b's. The merged conversation starts at the earlier
+// StartedAt and ends at the later EndedAt. It keeps a's ID and, where a leaves
+// them unset, takes b's status, channel, schema, context, and time budget.
+// Metadata counts are recomputed, as is FinalState when either input has one.
+// Neither input is modified.
+func MergeConversations(a, b Conversation, options ...MergeOption) (Conversation, error) {
+	policy := mergePolicy{}
+	for _, option := range options {
+		option(&policy)
+	}
+
+	merged := a.Clone()
+	other := b.Clone()
+
+	// Union participants by ID
+	participants := make(map[string]Participant, len(merged.Participants))
+	for _, participant := range merged.Participants {
+		participants[participant.ID] = participant
+	}
+	for _, participant := range other.Participants {
+		existing, ok := participants[participant.ID]
+		if !ok {
+			merged.Participants = append(merged.Participants, participant)
+			participants[participant.ID] = participant
+			continue
+		}
+		if !reflect.DeepEqual(existing, participant) {
+			return Conversation{}, fmt.Errorf("conflicting definitions for participant %s", participant.ID)
+		}
+	}
+
+	// Concatenate acts, handling IDs present in both
+	seen := make(map[string]bool, len(merged.Acts))
+	for _, act := range merged.Acts {
+		seen[act.GetAct().ID] = true
+	}
+	for _, act := range other.Acts {
+		id := act.GetAct().ID
+		if seen[id] {
+			if policy.dedupeActs {
+				continue
+			}
+			return Conversation{}, fmt.Errorf("duplicate act ID %s in both conversations", id)
+		}
+		seen[id] = true
+		merged.Acts = append(merged.Acts, act)
+	}
+	sort.SliceStable(merged.Acts, func(i, j int) bool {
+		return merged.Acts[i].GetAct().Timestamp.Before(merged.Acts[j].GetAct().Timestamp)
+	})
+
+	// Widen the time span
+	if other.StartedAt != nil && (merged.StartedAt == nil || other.StartedAt.Before(*merged.StartedAt)) {
+		merged.StartedAt = other.StartedAt
+	}
+	if other.EndedAt != nil && (merged.EndedAt == nil || other.EndedAt.After(*merged.EndedAt)) {
+		merged.EndedAt = other.EndedAt
+	}
+
+	// Fill fields a leaves unset
+	if merged.SchemaVersion == nil {
+		merged.SchemaVersion = other.SchemaVersion
+	}
+	if merged.Status == nil {
+		merged.Status = other.Status
+	}
+	if merged.Channel == nil {
+		merged.Channel = other.Channel
+	}
+	if merged.Schema == nil {
+		merged.Schema = other.Schema
+	}
+	if merged.Context == nil {
+		merged.Context = other.Context
+	}
+	if merged.TimeBudgetMs == nil {
+		merged.TimeBudgetMs = other.TimeBudgetMs
+	}
+
+	if merged.FinalState != nil || other.FinalState != nil {
+		merged.FinalState = merged.ComputeFinalState()
+	}
+	merged.updateMetadata()
+
+	return merged, nil
+}
+
+// ============================================================================
+// Entity Merging
+// ============================================================================
+
+// EntitiesEqual reports whether two entity references describe the same
+// logical entity: their IDs match and their types are compatible. Types are
+// compatible when they are equal or when either is empty, as it is for
+// entities normalized from bare string IDs. Other fields are not compared.
+func EntitiesEqual(a, b Entity) bool {
+	if a.ID != b.ID {
+		return false
+	}
+	return a.Type == "" || b.Type == "" || a.Type == b.Type
+}
+
+// EntityMergeOption configures how MergeEntity resolves conflicting fields
+type EntityMergeOption func(*entityMergePolicy)
+
+// entityMergePolicy holds the options applied by MergeEntity
+type entityMergePolicy struct {
+	preferOverlay bool
+}
+
+// PreferOverlay sets whether MergeEntity resolves a field set differently in
+// both entities in favour of the overlay. By default the base value is kept.
+func PreferOverlay(prefer bool) EntityMergeOption {
+	return func(p *entityMergePolicy) {
+		p.preferOverlay = prefer
+	}
+}
+
+// MergeEntity combines two references to the same entity into one, such as
+// an order seen with its external ID in one fact and its system in another.
+// Fields empty in base are filled from overlay. Metadata is merged key by key,
+// descending into nested objects. Where both set a field or metadata value
+// differently, base wins unless PreferOverlay is set. Use EntitiesEqual first
+// to check that the two refer to the same entity. Neither input is modified.
+func MergeEntity(base, overlay Entity, options ...EntityMergeOption) Entity {
+	policy := entityMergePolicy{}
+	for _, option := range options {
+		option(&policy)
+	}
+
+	merged := cloneEntity(base)
+	overlay = cloneEntity(overlay)
+
+	merged.ID = policy.mergeString(merged.ID, overlay.ID)
+	merged.Type = policy.mergeString(merged.Type, overlay.Type)
+	merged.ExternalID = policy.mergeStringPtr(merged.ExternalID, overlay.ExternalID)
+	merged.System = policy.mergeStringPtr(merged.System, overlay.System)
+	merged.Version = policy.mergeStringPtr(merged.Version, overlay.Version)
+	merged.SchemaURL = policy.mergeStringPtr(merged.SchemaURL, overlay.SchemaURL)
+	merged.Metadata = policy.mergeMetadata(merged.Metadata, overlay.Metadata)
+
+	return merged
+}
+
+// mergeString resolves a string field set in base and/or overlay
+func (p entityMergePolicy) mergeString(base, overlay string) string {
+	if base == "" || (overlay != "" && p.preferOverlay) {
+		return overlay
+	}
+	return base
+}
+
+// mergeStringPtr resolves an optional string field set in base and/or overlay
+func (p entityMergePolicy) mergeStringPtr(base, overlay *string) *string {
+	if overlay == nil || *overlay == "" {
+		return base
+	}
+	if base == nil || *base == "" || p.preferOverlay {
+		return overlay
+	}
+	return base
+}
+
+// mergeMetadata merges two metadata maps, descending into values that are
+// objects on both sides. The maps are owned by MergeEntity and are modified.
+func (p entityMergePolicy) mergeMetadata(base, overlay map[string]interface{}) map[string]interface{} {
+	if len(overlay) == 0 {
+		return base
+	}
+	if base == nil {
+		return overlay
+	}
+	for key, overlayValue := range overlay {
+		baseValue, ok := base[key]
+		if !ok {
+			base[key] = overlayValue
+			continue
+		}
+		baseMap, baseIsMap := baseValue.(map[string]interface{})
+		overlayMap, overlayIsMap := overlayValue.(map[string]interface{})
+		if baseIsMap && overlayIsMap {
+			base[key] = p.mergeMetadata(baseMap, overlayMap)
+		} else if p.preferOverlay {
+			base[key] = overlayValue
+		}
+	}
+	return base
+}
+
+// NormalizeEntityRefs rewrites the entity reference of every fact, confirm,
+// and commit to a structured Entity, so that references to the same entity
+// compare equal however they were written. References to the same entity are
+// combined with MergeEntity in conversation order, so every act carries the
+// most specific description seen anywhere in the conversation: a bare
+// "order_789" gains the type and external ID given by a structured reference
+// elsewhere, and a field set differently keeps the value seen first.
+// References that share an ID but have conflicting types are not combined and
+// are each normalized on their own. References that cannot be normalized are
+// left unchanged.
+func (c *Conversation) NormalizeEntityRefs() {
+	resolved := make(map[string]Entity)
+	conflicting := make(map[string]bool)
+	for _, act := range c.Acts {
+		entity, ok := actEntity(act)
+		if !ok {
+			continue
+		}
+		existing, seen := resolved[entity.ID]
+		switch {
+		case !seen:
+			resolved[entity.ID] = entity
+		case EntitiesEqual(existing, entity):
+			resolved[entity.ID] = MergeEntity(existing, entity)
+		default:
+			conflicting[entity.ID] = true
+		}
+	}
+
+	for i, act := range c.Acts {
+		entity, ok := actEntity(act)
+		if !ok {
+			continue
+		}
+		if !conflicting[entity.ID] {
+			entity = cloneEntity(resolved[entity.ID])
+		}
+		switch a := act.(type) {
+		case Fact:
+			a.Entity = entity
+			c.Acts[i] = a
+		case Confirm:
+			a.Entity = entity
+			c.Acts[i] = a
+		case Commit:
+			a.Entity = entity
+			c.Acts[i] = a
+		}
+	}
+}
+
+// actEntity returns the normalized entity a fact, confirm, or commit refers to
+func actEntity(act ConversationAct) (Entity, bool) {
+	var ref EntityRef
+	switch a := act.(type) {
+	case Fact:
+		ref = a.Entity
+	case Confirm:
+		ref = a.Entity
+	case Commit:
+		ref = a.Entity
+	default:
+		return Entity{}, false
+	}
+
+	entity, err := NormalizeEntityRef(ref)
+	if err != nil {
+		return Entity{}, false
+	}
+	return entity, true
+}
+
+// ============================================================================
+// Participant Merging
+// ============================================================================
+
+// DedupeParticipants merges participant records that describe the same
+// participant: records sharing an ID, an ExternalID, or an Email (compared
+// case-insensitively, ignoring surrounding space). Matching is transitive, so
+// a record sharing an email with one record and an external ID with another
+// joins both into one group.
+//
+// Each group is merged into its first record, which keeps its position and
+// ID. Fields unset on it are filled from the later records in order,
+// capabilities and permissions are unioned in order of first appearance, and
+// metadata keys missing from it are added. The returned map takes the ID of
+// every record merged away to the ID of the record it was merged into; rewrite
+// act speakers with it. The input is not modified.
+func DedupeParticipants(ps []Participant) ([]Participant, map[string]string) {
+	// parent implements union-find over record indexes
+	parent := make([]int, len(ps))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(i, j int) {
+		ri, rj := find(i), find(j)
+		if ri == rj {
+			return
+		}
+		// The earlier record stays the root
+		if rj < ri {
+			ri, rj = rj, ri
+		}
+		parent[rj] = ri
+	}
+
+	firstByKey := make(map[string]int)
+	for i, p := range ps {
+		keys := []string{"id:" + p.ID}
+		if p.ExternalID != nil && *p.ExternalID != "" {
+			keys = append(keys, "external_id:"+*p.ExternalID)
+		}
+		if p.Email != nil && strings.TrimSpace(*p.Email) != "" {
+			keys = append(keys, "email:"+strings.ToLower(strings.TrimSpace(*p.Email)))
+		}
+		for _, key := range keys {
+			if first, ok := firstByKey[key]; ok {
+				union(first, i)
+			} else {
+				firstByKey[key] = i
+			}
+		}
+	}
+
+	var merged []Participant
+	position := make(map[int]int)
+	remap := make(map[string]string)
+	for i, p := range ps {
+		root := find(i)
+		if root == i {
+			position[i] = len(merged)
+			merged = append(merged, cloneParticipant(p))
+			continue
+		}
+		canonical := &merged[position[root]]
+		mergeParticipant(canonical, p)
+		if p.ID != canonical.ID {
+			remap[p.ID] = canonical.ID
+		}
+	}
+	return merged, remap
+}
+
+// mergeParticipant fills the fields unset on canonical from other and unions
+// their capabilities, permissions, and metadata
+func mergeParticipant(canonical *Participant, other Participant) {
+	other = cloneParticipant(other)
+	if canonical.Type == "" {
+		canonical.Type = other.Type
+	}
+	canonical.Role = firstNonNil(canonical.Role, other.Role)
+	canonical.Name = firstNonNil(canonical.Name, other.Name)
+	canonical.Email = firstNonNil(canonical.Email, other.Email)
+	canonical.Phone = firstNonNil(canonical.Phone, other.Phone)
+	canonical.ExternalID = firstNonNil(canonical.ExternalID, other.ExternalID)
+	canonical.System = firstNonNil(canonical.System, other.System)
+	canonical.Preferences = firstNonNil(canonical.Preferences, other.Preferences)
+	canonical.Capabilities = unionStrings(canonical.Capabilities, other.Capabilities)
+	canonical.Permissions = unionStrings(canonical.Permissions, other.Permissions)
+	for key, value := range other.Metadata {
+		if canonical.Metadata == nil {
+			canonical.Metadata = make(map[string]interface{})
+		}
+		if _, ok := canonical.Metadata[key]; !ok {
+			canonical.Metadata[key] = value
+		}
+	}
+}
+
+// firstNonNil returns a unless it is nil
+func firstNonNil[T any](a, b *T) *T {
+	if a != nil {
+		return a
+	}
+	return b
+}
+
+// unionStrings appends the values of b missing from a
+func unionStrings(a, b []string) []string {
+	for _, value := range b {
+		if !slices.Contains(a, value) {
+			a = append(a, value)
+		}
+	}
+	return a
+}
@@ -0,0 +1,165 @@
+package astra
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Message Localization
+// ============================================================================
+
+// MessageKey identifies a user-facing message that can be translated
+type MessageKey string
+
+// Messages set by the constraint builders
+const (
+	MessageRequired    MessageKey = "required"
+	MessageMinLength   MessageKey = "min_length"
+	MessageMaxLength   MessageKey = "max_length"
+	MessageEmailFormat MessageKey = "email_format"
+	MessagePhoneFormat MessageKey = "phone_format"
+	MessageEnum        MessageKey = "enum"
+	MessageRange       MessageKey = "range"
+)
+
+// Messages reported by EvaluateConstraints for a constraint without a message
+const (
+	MessageValueRequired   MessageKey = "value_required"
+	MessageBelowMinLength  MessageKey = "below_min_length"
+	MessageAboveMaxLength  MessageKey = "above_max_length"
+	MessagePatternMismatch MessageKey = "pattern_mismatch"
+	MessageInvalidFormat   MessageKey = "invalid_format"
+	MessageBelowMinimum    MessageKey = "below_minimum"
+	MessageAboveMaximum    MessageKey = "above_maximum"
+	MessageNotInEnum       MessageKey = "not_in_enum"
+)
+
+// MessageCatalog maps message keys to translations. Translations are fmt
+// format strings taking the same arguments as the English message; use
+// explicit argument indexes such as %[2]v to reorder them.
+type MessageCatalog map[MessageKey]string
+
+// DefaultMessageLocale is the locale of the built-in messages, used when no
+// other translation is available
+const DefaultMessageLocale = "en"
+
+// englishMessages is the built-in catalog
+var englishMessages = MessageCatalog{
+	MessageRequired:    "This field is required",
+	MessageMinLength:   "Minimum length is %d characters",
+	MessageMaxLength:   "Maximum length is %d characters",
+	MessageEmailFormat: "Must be a valid email address",
+	MessagePhoneFormat: "Must be a valid phone number",
+	MessageEnum:        "Must be one of: %v",
+	MessageRange:       "Value must be within the specified range",
+
+	MessageValueRequired:   "value is required",
+	MessageBelowMinLength:  "length %d is less than minimum %v",
+	MessageAboveMaxLength:  "length %d exceeds maximum %v",
+	MessagePatternMismatch: "value does not match pattern %s",
+	MessageInvalidFormat:   "value is not a valid %s",
+	MessageBelowMinimum:    "value is below minimum %v",
+	MessageAboveMaximum:    "value is above maximum %v",
+	MessageNotInEnum:       "value must be one of %v",
+}
+
+// messageCatalogs holds the registered catalogs keyed by lowercased locale, and
+// messageLocale the locale used when none is given
+var (
+	messageCatalogsMu sync.RWMutex
+	messageCatalogs   = map[string]MessageCatalog{}
+	messageLocale     = DefaultMessageLocale
+)
+
+// RegisterMessageCatalog adds translations for a locale such as "es" or
+// "fr-CA". Registering the same locale again merges the new translations into
+// the existing ones. Keys missing from a catalog fall back to English.
+func RegisterMessageCatalog(locale string, catalog MessageCatalog) {
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		return
+	}
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+	merged := messageCatalogs[locale]
+	if merged == nil {
+		merged = make(MessageCatalog, len(catalog))
+		messageCatalogs[locale] = merged
+	}
+	for key, message := range catalog {
+		merged[key] = message
+	}
+}
+
+// SetMessageLocale sets the locale used by the constraint builders and
+// EvaluateConstraints. An empty locale restores DefaultMessageLocale.
+func SetMessageLocale(locale string) {
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		locale = DefaultMessageLocale
+	}
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+	messageLocale = locale
+}
+
+// MessageLocale returns the locale set with SetMessageLocale
+func MessageLocale() string {
+	messageCatalogsMu.RLock()
+	defer messageCatalogsMu.RUnlock()
+	return messageLocale
+}
+
+// MessageLocale returns the locale to use for messages shown to the
+// participant: their preferred language, or the locale set with
+// SetMessageLocale when they have none
+func (p Participant) MessageLocale() string {
+	if p.Preferences != nil && p.Preferences.Language != nil && *p.Preferences.Language != "" {
+		return *p.Preferences.Language
+	}
+	return MessageLocale()
+}
+
+// LocalizeMessage renders a message in the given locale. A regional locale
+// such as "es-MX" falls back to its language ("es") and then to English; an
+// empty locale uses the one set with SetMessageLocale.
+func LocalizeMessage(locale string, key MessageKey, args ...interface{}) string {
+	format, ok := lookupMessage(locale, key)
+	if !ok {
+		return string(key)
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// lookupMessage finds the translation of a message for a locale
+func lookupMessage(locale string, key MessageKey) (string, bool) {
+	messageCatalogsMu.RLock()
+	defer messageCatalogsMu.RUnlock()
+
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		locale = messageLocale
+	}
+	for {
+		if message, ok := messageCatalogs[locale][key]; ok {
+			return message, true
+		}
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	message, ok := englishMessages[key]
+	return message, ok
+}
+
+// normalizeLocale lowercases a locale and uses "-" to separate its parts
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
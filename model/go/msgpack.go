@@ -0,0 +1,165 @@
+package astra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// ============================================================================
+// MessagePack Marshaling and Unmarshaling helpers
+// ============================================================================
+//
+// As with YAML, the structs are not handed to the MessagePack library
+// directly: it would bypass MarshalJSON/UnmarshalJSON, losing additional
+// properties and the act type of each entry in Conversation.Acts. Values are
+// converted to their JSON form and that document is encoded as MessagePack, so
+// the wire form carries exactly the fields and discriminators of the JSON
+// encoding. Integers are kept as compact integers rather than floats, which
+// together with the binary framing makes the encoding smaller than JSON.
+
+// MarshalActMsgpack marshals any ConversationAct to MessagePack
+func MarshalActMsgpack(act ConversationAct) ([]byte, error) {
+	return marshalMsgpackViaJSON(act)
+}
+
+// UnmarshalActMsgpack unmarshals MessagePack to the appropriate ConversationAct type
+func UnmarshalActMsgpack(data []byte) (ConversationAct, error) {
+	jsonData, err := msgpackToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalAct(jsonData)
+}
+
+// MarshalConversationMsgpack marshals a Conversation to MessagePack
+func MarshalConversationMsgpack(c Conversation) ([]byte, error) {
+	return marshalMsgpackViaJSON(c)
+}
+
+// UnmarshalConversationMsgpack unmarshals MessagePack to a Conversation
+func UnmarshalConversationMsgpack(data []byte) (Conversation, error) {
+	var c Conversation
+
+	jsonData, err := msgpackToJSON(data)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(jsonData, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// marshalMsgpackViaJSON marshals a value to JSON and re-encodes the result as
+// MessagePack. Map keys are sorted so the output is deterministic.
+func marshalMsgpackViaJSON(v interface{}) ([]byte, error) {
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := msgpack.NewEncoder(&buf)
+	encoder.SetSortMapKeys(true)
+	encoder.UseCompactInts(true)
+	encoder.UseCompactFloats(true)
+	if err := encoder.Encode(compactJSONNumbers(generic)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// compactJSONNumbers replaces the json.Numbers in a decoded JSON document with
+// integers where they fit, and floats otherwise
+func compactJSONNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return u
+		}
+		f, _ := strconv.ParseFloat(string(v), 64)
+		return f
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = compactJSONNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = compactJSONNumbers(item)
+		}
+	}
+	return value
+}
+
+// msgpackToJSON decodes a MessagePack document and re-encodes it as JSON
+func msgpackToJSON(data []byte) ([]byte, error) {
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	generic, err := decoder.DecodeInterfaceLoose()
+	if err != nil {
+		return nil, fmt.Errorf("invalid MessagePack: %w", err)
+	}
+
+	converted, err := convertMsgpackValue(generic)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(converted)
+}
+
+// convertMsgpackValue converts decoded MessagePack into values encoding/json
+// can marshal. MessagePack allows non-string map keys, which JSON does not.
+func convertMsgpackValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			convertedItem, err := convertMsgpackValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = convertedItem
+		}
+		return v, nil
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported MessagePack map key %v of type %T", key, key)
+			}
+			convertedItem, err := convertMsgpackValue(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[keyString] = convertedItem
+		}
+		return converted, nil
+	case []interface{}:
+		for i, item := range v {
+			convertedItem, err := convertMsgpackValue(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = convertedItem
+		}
+		return v, nil
+	case []byte:
+		return nil, fmt.Errorf("unsupported MessagePack binary value")
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,161 @@
+package astra
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// ============================================================================
+// Pseudonymization
+// ============================================================================
+
+// Pseudonymize returns a deep copy of the conversation with participant
+// identities replaced by deterministic tokens derived from key with
+// HMAC-SHA256. Participant IDs, every act's Speaker, and entity references
+// and final state entries naming a participant or speaker are rewritten to the
+// same token, so the conversation stays internally consistent. Participant
+// names, emails, phone numbers, and external IDs are replaced as well; emails
+// are compared case-insensitively and phone numbers after normalization to
+// E.164.
+//
+// Tokens depend only on the key and the original value, so a participant maps
+// to the same token in every conversation pseudonymized with the same key.
+// Keep the key secret: anyone holding it can test guesses of the original
+// values. Free text such as act metadata original_text is not rewritten; use
+// Redact for that. The original conversation is left untouched.
+func (c Conversation) Pseudonymize(key []byte) Conversation {
+	p := pseudonymizer{key: key}
+	pseudonymized := c.Clone()
+
+	identities := make(map[string]bool, len(pseudonymized.Participants))
+	for i := range pseudonymized.Participants {
+		participant := &pseudonymized.Participants[i]
+		identities[participant.ID] = true
+		participant.ID = p.id(participant.ID)
+		participant.Name = p.optional("name", participant.Name)
+		participant.Email = p.email(participant.Email)
+		participant.Phone = p.phone(participant.Phone)
+		participant.ExternalID = p.optional("external_id", participant.ExternalID)
+	}
+	for _, act := range pseudonymized.Acts {
+		identities[act.GetAct().Speaker] = true
+	}
+
+	for i, act := range pseudonymized.Acts {
+		switch a := act.(type) {
+		case Ask:
+			a.Speaker = p.id(a.Speaker)
+			pseudonymized.Acts[i] = a
+		case Fact:
+			a.Speaker = p.id(a.Speaker)
+			a.Entity = p.entityRef(a.Entity, identities)
+			pseudonymized.Acts[i] = a
+		case Confirm:
+			a.Speaker = p.id(a.Speaker)
+			a.Entity = p.entityRef(a.Entity, identities)
+			pseudonymized.Acts[i] = a
+		case Commit:
+			a.Speaker = p.id(a.Speaker)
+			a.Entity = p.entityRef(a.Entity, identities)
+			pseudonymized.Acts[i] = a
+		case Error:
+			a.Speaker = p.id(a.Speaker)
+			pseudonymized.Acts[i] = a
+		}
+	}
+
+	if len(pseudonymized.FinalState) > 0 {
+		finalState := make(map[string]interface{}, len(pseudonymized.FinalState))
+		for entityID, state := range pseudonymized.FinalState {
+			if identities[entityID] {
+				entityID = p.id(entityID)
+			}
+			finalState[entityID] = state
+		}
+		pseudonymized.FinalState = finalState
+	}
+
+	return pseudonymized
+}
+
+// pseudonymizer derives the tokens used by Conversation.Pseudonymize
+type pseudonymizer struct {
+	key []byte
+}
+
+// token returns the HMAC of a value, prefixed with its kind so that equal
+// strings of different kinds do not share a token
+func (p pseudonymizer) token(kind, value string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:12])
+}
+
+// id returns the token for a participant or speaker ID. Empty IDs are kept.
+func (p pseudonymizer) id(id string) string {
+	if id == "" {
+		return id
+	}
+	return "participant_" + p.token("id", id)
+}
+
+// optional returns the token for an optional string, leaving nil unchanged
+func (p pseudonymizer) optional(kind string, s *string) *string {
+	if s == nil {
+		return nil
+	}
+	token := kind + "_" + p.token(kind, *s)
+	return &token
+}
+
+// email returns the token for an optional email address, keeping the result
+// in email form
+func (p pseudonymizer) email(email *string) *string {
+	if email == nil {
+		return nil
+	}
+	token := p.token("email", strings.ToLower(strings.TrimSpace(*email))) + "@pseudonymized.invalid"
+	return &token
+}
+
+// phone returns the token for an optional phone number
+func (p pseudonymizer) phone(phone *string) *string {
+	if phone == nil {
+		return nil
+	}
+	value := *phone
+	if normalized, err := NormalizePhone(value); err == nil {
+		value = normalized
+	}
+	token := "phone_" + p.token("phone", value)
+	return &token
+}
+
+// entityRef rewrites an entity reference whose ID names a participant or
+// speaker, preserving the reference's shape
+func (p pseudonymizer) entityRef(ref EntityRef, identities map[string]bool) EntityRef {
+	switch e := ref.(type) {
+	case string:
+		if identities[e] {
+			return p.id(e)
+		}
+	case Entity:
+		if identities[e.ID] {
+			e.ID = p.id(e.ID)
+		}
+		return e
+	case *Entity:
+		if e != nil && identities[e.ID] {
+			e.ID = p.id(e.ID)
+		}
+	case map[string]interface{}:
+		if id, ok := e["id"].(string); ok && identities[id] {
+			e["id"] = p.id(id)
+		}
+	}
+	return ref
+}
@@ -0,0 +1,64 @@
+package astra
+
+import (
+	"slices"
+	"time"
+)
+
+// ============================================================================
+// Act Queries
+// ============================================================================
+
+// ActFilter selects acts by any combination of criteria. Unset fields do not
+// filter; an act must satisfy every set field to match.
+type ActFilter struct {
+	// Match acts of any of these types
+	Types []ActType
+	// Match acts by any of these speakers
+	Speakers []string
+	// Match acts timestamped strictly after this time
+	After *time.Time
+	// Match acts timestamped strictly before this time
+	Before *time.Time
+	// Match acts whose confidence is at least this value. As with
+	// FilterByConfidence, unscored acts are treated as meeting the threshold.
+	MinConfidence *float64
+	// Match facts, confirms, and commits about this entity. Both string and
+	// structured entity references are matched by their resolved ID.
+	EntityID *string
+}
+
+// Query returns the acts matching the filter, in conversation order. All set
+// criteria are applied in a single pass over the acts.
+func (c *Conversation) Query(f ActFilter) []ConversationAct {
+	confidence := newConfidencePolicy(nil)
+
+	var acts []ConversationAct
+	for _, act := range c.Acts {
+		base := act.GetAct()
+
+		if len(f.Types) > 0 && !slices.Contains(f.Types, base.Type) {
+			continue
+		}
+		if len(f.Speakers) > 0 && !slices.Contains(f.Speakers, base.Speaker) {
+			continue
+		}
+		if f.After != nil && !base.Timestamp.After(*f.After) {
+			continue
+		}
+		if f.Before != nil && !base.Timestamp.Before(*f.Before) {
+			continue
+		}
+		if f.MinConfidence != nil && !confidence.meets(act, *f.MinConfidence) {
+			continue
+		}
+		if f.EntityID != nil {
+			if id, ok := actEntityID(act); !ok || id != *f.EntityID {
+				continue
+			}
+		}
+
+		acts = append(acts, act)
+	}
+	return acts
+}
@@ -0,0 +1,158 @@
+package astra
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// ============================================================================
+// PII Redaction
+// ============================================================================
+
+// DefaultRedactionMask is the token that replaces redacted values
+const DefaultRedactionMask = "[REDACTED]"
+
+// RedactOptions controls which values Conversation.Redact scrubs.
+// Participant email and phone numbers and the context IP address are always
+// redacted; the remaining options widen or tune the redaction.
+type RedactOptions struct {
+	// Fact fields (by field name) whose values are redacted
+	FactFields []string
+	// Entity types whose facts are redacted regardless of field name.
+	// Only structured entity references carry a type.
+	EntityTypes []string
+	// Redact participant display names
+	RedactNames bool
+	// Keep act metadata original_text instead of redacting it
+	KeepOriginalText bool
+	// Replace values with a stable hash token instead of the mask, so that
+	// equal values still correlate across redacted conversations
+	Hash bool
+	// Salt mixed into hash tokens when Hash is set
+	Salt string
+	// Token that replaces redacted values when Hash is not set
+	// (defaults to DefaultRedactionMask)
+	Mask string
+}
+
+// Redact returns a deep copy of the conversation with personally identifiable
+// information replaced by a masked token. Act IDs and conversation structure
+// are preserved and the original conversation is left untouched.
+func (c Conversation) Redact(opts RedactOptions) Conversation {
+	redacted := c.Clone()
+
+	fields := make(map[string]bool, len(opts.FactFields))
+	for _, field := range opts.FactFields {
+		fields[field] = true
+	}
+	entityTypes := make(map[string]bool, len(opts.EntityTypes))
+	for _, entityType := range opts.EntityTypes {
+		entityTypes[entityType] = true
+	}
+
+	for i := range redacted.Participants {
+		participant := &redacted.Participants[i]
+		participant.Email = opts.redactString(participant.Email)
+		participant.Phone = opts.redactString(participant.Phone)
+		if opts.RedactNames {
+			participant.Name = opts.redactString(participant.Name)
+		}
+	}
+
+	if redacted.Context != nil {
+		redacted.Context.IPAddress = opts.redactString(redacted.Context.IPAddress)
+	}
+
+	for i, act := range redacted.Acts {
+		switch a := act.(type) {
+		case Ask:
+			a.Act = opts.redactBaseAct(a.Act)
+			redacted.Acts[i] = a
+		case Fact:
+			a.Act = opts.redactBaseAct(a.Act)
+			if fields[a.Field] || entityTypes[entityRefType(a.Entity)] {
+				a.Value = opts.redactValue(a.Value)
+				if a.PreviousValue != nil {
+					a.PreviousValue = opts.redactValue(a.PreviousValue)
+				}
+			}
+			redacted.Acts[i] = a
+		case Confirm:
+			a.Act = opts.redactBaseAct(a.Act)
+			redacted.Acts[i] = a
+		case Commit:
+			a.Act = opts.redactBaseAct(a.Act)
+			redacted.Acts[i] = a
+		case Error:
+			a.Act = opts.redactBaseAct(a.Act)
+			redacted.Acts[i] = a
+		}
+	}
+
+	// Final state mirrors fact values, keyed by entity then field
+	for _, state := range redacted.FinalState {
+		entityState, ok := state.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for field, value := range entityState {
+			if fields[field] {
+				entityState[field] = opts.redactValue(value)
+			}
+		}
+	}
+
+	return redacted
+}
+
+// redactBaseAct redacts the free-form text carried in act metadata
+func (opts RedactOptions) redactBaseAct(act Act) Act {
+	if act.Metadata != nil && !opts.KeepOriginalText {
+		act.Metadata.OriginalText = opts.redactString(act.Metadata.OriginalText)
+	}
+	return act
+}
+
+// redactString redacts an optional string, leaving nil unchanged
+func (opts RedactOptions) redactString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	token := opts.token(*s)
+	return &token
+}
+
+// redactValue replaces an arbitrary value with a redaction token
+func (opts RedactOptions) redactValue(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		return opts.token(s)
+	}
+	return opts.token(fmt.Sprintf("%v", value))
+}
+
+// token returns the replacement for a redacted value
+func (opts RedactOptions) token(value string) string {
+	if opts.Hash {
+		sum := sha256.Sum256([]byte(opts.Salt + value))
+		return "sha256:" + hex.EncodeToString(sum[:8])
+	}
+	if opts.Mask != "" {
+		return opts.Mask
+	}
+	return DefaultRedactionMask
+}
+
+// entityRefType returns the type of a structured entity reference, or "" for
+// plain string IDs
+func entityRefType(ref EntityRef) string {
+	switch e := ref.(type) {
+	case Entity:
+		return e.Type
+	case *Entity:
+		if e != nil {
+			return e.Type
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package astra
+
+import (
+	"math"
+	mathrand "math/rand"
+	"time"
+)
+
+// ============================================================================
+// Commit Retries
+// ============================================================================
+
+// CanRetry reports whether another attempt at the commit is allowed: it has
+// failed or is retrying, its error is recoverable, and fewer than MaxRetries
+// retries have been made. A commit without MaxRetries has no retry budget, and
+// a missing RetryCount counts as no retries yet.
+func (c Commit) CanRetry() bool {
+	if c.Status == nil || (*c.Status != CommitStatusFailed && *c.Status != CommitStatusRetrying) {
+		return false
+	}
+	if c.Error == nil || !c.Error.Recoverable {
+		return false
+	}
+	if c.MaxRetries == nil {
+		return false
+	}
+	retries := 0
+	if c.RetryCount != nil {
+		retries = *c.RetryCount
+	}
+	return retries < *c.MaxRetries
+}
+
+// NextRetryBackoff returns how long to wait before a retry, using exponential
+// backoff with jitter. Attempt 0 is the first retry; each attempt doubles the
+// delay, starting from base. The result is drawn uniformly from the upper half
+// of the delay, [delay/2, delay), so that integrations retrying together spread
+// out while still backing off. A non-positive base or negative attempt returns
+// zero, and delays too large to represent are capped.
+func NextRetryBackoff(attempt int, base time.Duration) time.Duration {
+	if base <= 0 || attempt < 0 {
+		return 0
+	}
+
+	delay := time.Duration(math.MaxInt64)
+	if attempt < 63 && base <= delay>>uint(attempt) {
+		delay = base << uint(attempt)
+	}
+
+	half := delay / 2
+	if half == 0 {
+		return delay
+	}
+	return half + time.Duration(mathrand.Int63n(int64(delay-half)))
+}
+
+// ============================================================================
+// Ask Retries
+// ============================================================================
+
+// ShouldEscalate reports whether the ask has used up its retries and should be
+// handed to a human rather than asked again. An ask without MaxRetries never
+// escalates, and a missing RetryCount counts as no retries yet.
+func (a Ask) ShouldEscalate() bool {
+	if a.MaxRetries == nil {
+		return false
+	}
+	retries := 0
+	if a.RetryCount != nil {
+		retries = *a.RetryCount
+	}
+	return retries >= *a.MaxRetries
+}
+
+// IncrementRetry returns a copy of the ask with its RetryCount increased by
+// one. The original ask is not modified.
+func (a Ask) IncrementRetry() Ask {
+	retries := 1
+	if a.RetryCount != nil {
+		retries = *a.RetryCount + 1
+	}
+	a.RetryCount = &retries
+	return a
+}
@@ -0,0 +1,60 @@
+package astra
+
+import "sync"
+
+// ============================================================================
+// Concurrency-Safe Conversations
+// ============================================================================
+
+// SafeConversation wraps a Conversation so that acts can be added and queried
+// from multiple goroutines. Writes take an exclusive lock while reads share a
+// read lock, so concurrent readers never serialize behind each other.
+//
+// The plain Conversation remains lock-free and is the right choice for
+// single-threaded use; it must not be accessed concurrently.
+type SafeConversation struct {
+	mu   sync.RWMutex
+	conv Conversation
+}
+
+// NewSafeConversation wraps a conversation for concurrent use. The caller must
+// not keep using the wrapped conversation directly.
+func NewSafeConversation(conv Conversation) *SafeConversation {
+	return &SafeConversation{conv: conv}
+}
+
+// AddAct validates an act and appends it to the conversation
+func (s *SafeConversation) AddAct(act ConversationAct) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conv.AddAct(act)
+}
+
+// GetActByID finds an act by its ID, returning an ActNotFoundError if no act matches
+func (s *SafeConversation) GetActByID(id string) (ConversationAct, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.conv.GetActByID(id)
+}
+
+// GetActsByType returns all acts of a specific type from the conversation
+func (s *SafeConversation) GetActsByType(actType ActType) []ConversationAct {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.conv.GetActsByType(actType)
+}
+
+// Stats computes a ConversationStats breakdown of the conversation's acts
+func (s *SafeConversation) Stats() ConversationStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.conv.Stats()
+}
+
+// Snapshot returns a deep copy of the conversation as it currently stands. The
+// copy is a plain Conversation and is safe to use without further locking.
+func (s *SafeConversation) Snapshot() Conversation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.conv.Clone()
+}
@@ -3,6 +3,14 @@ package astra
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 )
 
 // Schema represents a JSON Schema definition
@@ -59,6 +67,23 @@ var Schemas = struct {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":        "object",
 				"description": "Additional context-specific metadata",
@@ -124,6 +149,23 @@ var Schemas = struct {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":                 "object",
 				"description":          "Additional context-specific metadata",
@@ -173,6 +215,11 @@ var Schemas = struct {
 				"pattern":     "^conv_[a-zA-Z0-9_-]+$",
 				"description": "Unique identifier for this conversation",
 			},
+			"schema_version": map[string]interface{}{
+				"type":        "string",
+				"pattern":     "^v[0-9]+$",
+				"description": "ASTRA schema version this conversation was written against",
+			},
 			"participants": map[string]interface{}{
 				"type":     "array",
 				"minItems": 1,
@@ -276,6 +323,11 @@ var Schemas = struct {
 				"description":          "Final computed state of all entities after processing all acts",
 				"additionalProperties": true,
 			},
+			"time_budget_ms": map[string]interface{}{
+				"type":        "integer",
+				"minimum":     0,
+				"description": "Time budget for the conversation in milliseconds (SLA)",
+			},
 			"metadata": map[string]interface{}{
 				"type":        "object",
 				"description": "Additional conversation metadata",
@@ -342,6 +394,133 @@ func GetSchema(name string) (Schema, error) {
 	}
 }
 
+// definitionsRefPrefix and defsRefPrefix begin the local $ref pointers used by
+// the embedded schemas and by ResolvedConversationSchema respectively. Only the
+// former are resolved through the validator's table of embedded schemas; the
+// latter must be defined by the document that uses them.
+const (
+	definitionsRefPrefix = "#/definitions/"
+	defsRefPrefix        = "#/$defs/"
+)
+
+// ResolvedConversationSchema returns the conversation schema as a
+// self-contained draft 2020-12 document. The embedded schema refers to the act
+// schemas through "#/definitions/..." pointers that it does not define; here
+// each referenced schema is inlined under $defs and the pointers are rewritten
+// to "#/$defs/...". The result is a deep copy and may be modified freely.
+func ResolvedConversationSchema() Schema {
+	defs := make(map[string]interface{})
+
+	var resolve func(node interface{}) interface{}
+	resolve = func(node interface{}) interface{} {
+		switch n := node.(type) {
+		case Schema:
+			return resolve(map[string]interface{}(n))
+		case map[string]interface{}:
+			resolved := make(map[string]interface{}, len(n))
+			for key, child := range n {
+				ref, isRef := child.(string)
+				if key != "$ref" || !isRef || !strings.HasPrefix(ref, definitionsRefPrefix) {
+					resolved[key] = resolve(child)
+					continue
+				}
+
+				name := strings.TrimPrefix(ref, definitionsRefPrefix)
+				if _, inlined := defs[name]; !inlined {
+					schema, err := GetSchema(name)
+					if err != nil {
+						resolved[key] = ref
+						continue
+					}
+					defs[name] = nil // placeholder in case the schema refers back to itself
+					def := resolve(schema).(map[string]interface{})
+					delete(def, "$schema")
+					defs[name] = def
+				}
+				resolved[key] = defsRefPrefix + name
+			}
+			return resolved
+		case []map[string]interface{}:
+			resolved := make([]map[string]interface{}, len(n))
+			for i, child := range n {
+				resolved[i] = resolve(child).(map[string]interface{})
+			}
+			return resolved
+		case []interface{}:
+			resolved := make([]interface{}, len(n))
+			for i, child := range n {
+				resolved[i] = resolve(child)
+			}
+			return resolved
+		case []string:
+			return append([]string(nil), n...)
+		default:
+			return node
+		}
+	}
+
+	schema := resolve(Schemas.Conversation).(map[string]interface{})
+	schema["$defs"] = defs
+	return Schema(schema)
+}
+
+// exportedSchema returns a schema in the form published to other tools, with
+// the conversation schema made self-contained
+func exportedSchema(name string) (Schema, error) {
+	if name == "conversation" {
+		return ResolvedConversationSchema(), nil
+	}
+	return GetSchema(name)
+}
+
+// SchemaJSON returns a named schema as canonical JSON: compact, with object keys
+// in sorted order, so the output is stable across runs. The conversation schema
+// is returned in its self-contained form (see ResolvedConversationSchema).
+func SchemaJSON(name string) ([]byte, error) {
+	schema, err := exportedSchema(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s schema: %w", name, err)
+	}
+	return data, nil
+}
+
+// WriteSchemas writes every embedded schema to dir as pretty-printed JSON, for
+// tooling in other languages to consume. Each file is named after the last
+// segment of the schema's $id (for example "ask.json"), falling back to the
+// schema name. The conversation schema is written in its self-contained form
+// (see ResolvedConversationSchema). The directory is created if it does not
+// exist.
+func WriteSchemas(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create schema directory: %w", err)
+	}
+
+	for _, name := range ListSchemas() {
+		schema, err := exportedSchema(name)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s schema: %w", name, err)
+		}
+
+		filename := name + ".json"
+		if id, ok := schema["$id"].(string); ok && id != "" {
+			filename = path.Base(id)
+		}
+		if err := os.WriteFile(filepath.Join(dir, filename), append(data, '\n'), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s schema: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateJSON validates a JSON byte slice against a named schema
 func ValidateJSON(data []byte, schemaName string) error {
 	schema, err := GetSchema(schemaName)
@@ -359,10 +538,211 @@ func ValidateJSON(data []byte, schemaName string) error {
 	return validateAgainstSchema(jsonData, schema)
 }
 
+// ValidateFiles validates a batch of ASTRA JSON files, such as a directory of
+// fixtures. The schema for each file is inferred from its shape: documents
+// carrying participants or acts are checked as conversations, anything else is
+// checked against the schema for its act type. Compiled patterns and the $ref
+// resolver are shared across the whole batch. Files that validate cleanly are
+// omitted from the result.
+func ValidateFiles(paths []string) map[string][]error {
+	validator := newSchemaValidator()
+	results := make(map[string][]error)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			results[path] = append(results[path], fmt.Errorf("failed to read file: %w", err))
+			continue
+		}
+		if err := validator.validateDocument(data); err != nil {
+			results[path] = append(results[path], err)
+		}
+	}
+
+	return results
+}
+
+// defaultSchemaValidator is shared by ValidateJSON and the other single
+// document entry points. Only its pattern cache changes after initialization.
+var defaultSchemaValidator = newSchemaValidator()
+
+// schemaValidator validates decoded JSON documents against the embedded schemas.
+// Patterns are compiled once and local $ref pointers are resolved through a
+// fixed table, so a single validator can be reused across many documents, and
+// concurrently. A validator scoped to a document by forDocument resolves
+// pointers against that document's own definitions before its parent's table.
+type schemaValidator struct {
+	mu       sync.RWMutex
+	patterns map[string]*regexp.Regexp
+	refs     map[string]Schema
+	parent   *schemaValidator
+}
+
+// newSchemaValidator compiles every pattern found in the embedded schemas and
+// builds the $ref table used to resolve act and entity definitions
+func newSchemaValidator() *schemaValidator {
+	v := &schemaValidator{
+		patterns: make(map[string]*regexp.Regexp),
+		refs:     make(map[string]Schema),
+	}
+
+	for _, name := range ListSchemas() {
+		schema, err := GetSchema(name)
+		if err != nil {
+			continue
+		}
+		v.refs[definitionsRefPrefix+name] = schema
+		v.compilePatterns(schema)
+	}
+
+	return v
+}
+
+// compilePatterns walks a schema node and compiles every pattern keyword it finds
+func (v *schemaValidator) compilePatterns(node interface{}) {
+	switch n := node.(type) {
+	case Schema:
+		v.compilePatterns(map[string]interface{}(n))
+	case map[string]interface{}:
+		for key, child := range n {
+			if pattern, ok := child.(string); ok && key == "pattern" {
+				if _, seen := v.patterns[pattern]; !seen {
+					if re, err := regexp.Compile(pattern); err == nil {
+						v.patterns[pattern] = re
+					}
+				}
+				continue
+			}
+			v.compilePatterns(child)
+		}
+	case []map[string]interface{}:
+		for _, child := range n {
+			v.compilePatterns(child)
+		}
+	case []interface{}:
+		for _, child := range n {
+			v.compilePatterns(child)
+		}
+	}
+}
+
+// forDocument returns a validator for a document that may carry its own
+// definitions or $defs. Patterns are shared with v. If the document defines
+// nothing, v itself is returned.
+func (v *schemaValidator) forDocument(root Schema) *schemaValidator {
+	refs := documentRefs(root)
+	if len(refs) == 0 {
+		return v
+	}
+	return &schemaValidator{refs: refs, parent: v}
+}
+
+// documentRefs maps the local $ref pointers a schema can resolve itself, such
+// as "#/$defs/ask", to the definitions they point at
+func documentRefs(schema Schema) map[string]Schema {
+	refs := make(map[string]Schema)
+	for _, key := range []string{"definitions", "$defs"} {
+		definitions, _ := schema[key].(map[string]interface{})
+		for name, definition := range definitions {
+			switch d := definition.(type) {
+			case Schema:
+				refs["#/"+key+"/"+name] = d
+			case map[string]interface{}:
+				refs["#/"+key+"/"+name] = Schema(d)
+			}
+		}
+	}
+	return refs
+}
+
+// resolveRef resolves a local $ref pointer such as "#/definitions/ask",
+// trying the validator's own table before its parent's
+func (v *schemaValidator) resolveRef(ref string) (Schema, error) {
+	if schema, ok := v.refs[ref]; ok {
+		return schema, nil
+	}
+	if v.parent != nil {
+		return v.parent.resolveRef(ref)
+	}
+	return nil, fmt.Errorf("unresolvable $ref: %s", ref)
+}
+
+// pattern returns the compiled form of a pattern. Patterns that do not appear
+// in the embedded schemas are compiled on first use and cached.
+func (v *schemaValidator) pattern(pattern string) (*regexp.Regexp, error) {
+	if v.parent != nil {
+		return v.parent.pattern(pattern)
+	}
+	v.mu.RLock()
+	re, ok := v.patterns[pattern]
+	v.mu.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	v.patterns[pattern] = re
+	v.mu.Unlock()
+	return re, nil
+}
+
+// validateDocument parses a JSON document, infers its schema and validates it
+func (v *schemaValidator) validateDocument(data []byte) error {
+	var jsonData interface{}
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	schemaName, err := inferSchemaName(jsonData)
+	if err != nil {
+		return err
+	}
+
+	schema, err := v.resolveRef(definitionsRefPrefix + schemaName)
+	if err != nil {
+		return err
+	}
+
+	if err := v.validate(jsonData, schema); err != nil {
+		return fmt.Errorf("%s: %w", schemaName, err)
+	}
+	return nil
+}
+
+// inferSchemaName determines whether a decoded document is a conversation or an
+// act, returning the name of the schema it should be validated against
+func inferSchemaName(data interface{}) (string, error) {
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("expected object, got %T", data)
+	}
+
+	_, hasActs := dataMap["acts"]
+	_, hasParticipants := dataMap["participants"]
+	if hasActs || hasParticipants {
+		return "conversation", nil
+	}
+
+	if actType, ok := dataMap["type"].(string); ok && isValidActType(ActType(actType)) {
+		return actType, nil
+	}
+
+	return "", fmt.Errorf("unable to infer schema: document is neither a conversation nor an act")
+}
+
 // validateAgainstSchema performs basic validation against a schema
 // Note: This is a simplified validator. For full JSON Schema validation,
 // consider using a dedicated library like github.com/xeipuuv/gojsonschema
 func validateAgainstSchema(data interface{}, schema Schema) error {
+	return defaultSchemaValidator.forDocument(schema).validate(data, schema)
+}
+
+// validate performs basic validation of a decoded value against a schema
+func (v *schemaValidator) validate(data interface{}, schema Schema) error {
 	// Check if data is an object when schema expects object
 	if schemaType, ok := schema["type"].(string); ok && schemaType == "object" {
 		dataMap, ok := data.(map[string]interface{})
@@ -370,6 +750,22 @@ func validateAgainstSchema(data interface{}, schema Schema) error {
 			return fmt.Errorf("expected object, got %T", data)
 		}
 		
+		// Reject unknown keys when the schema is closed
+		if properties, ok := schema["properties"].(map[string]interface{}); ok {
+			if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				var unknown []string
+				for key := range dataMap {
+					if _, exists := properties[key]; !exists {
+						unknown = append(unknown, key)
+					}
+				}
+				if len(unknown) > 0 {
+					sort.Strings(unknown)
+					return fmt.Errorf("unknown properties: %s", strings.Join(unknown, ", "))
+				}
+			}
+		}
+		
 		// Check required fields
 		if required, ok := schema["required"].([]string); ok {
 			for _, field := range required {
@@ -384,7 +780,7 @@ func validateAgainstSchema(data interface{}, schema Schema) error {
 			for key, value := range dataMap {
 				if propSchema, exists := properties[key]; exists {
 					if propMap, ok := propSchema.(map[string]interface{}); ok {
-						if err := validateProperty(value, propMap); err != nil {
+						if err := v.validateProperty(value, propMap); err != nil {
 							return fmt.Errorf("validation failed for property %s: %w", key, err)
 						}
 					}
@@ -397,7 +793,23 @@ func validateAgainstSchema(data interface{}, schema Schema) error {
 }
 
 // validateProperty validates a single property against its schema
-func validateProperty(value interface{}, propSchema map[string]interface{}) error {
+func (v *schemaValidator) validateProperty(value interface{}, propSchema map[string]interface{}) error {
+	// Follow local references
+	if ref, ok := propSchema["$ref"].(string); ok {
+		resolved, err := v.resolveRef(ref)
+		if err != nil {
+			return err
+		}
+		return v.validate(value, resolved)
+	}
+
+	// Check that exactly one alternative matches
+	if branches := schemaBranches(propSchema["oneOf"]); branches != nil {
+		if err := v.validateOneOf(value, branches); err != nil {
+			return err
+		}
+	}
+
 	// Check type constraints
 	if expectedType, ok := propSchema["type"].(string); ok {
 		if !validateType(value, expectedType) {
@@ -412,8 +824,14 @@ func validateProperty(value interface{}, propSchema map[string]interface{}) erro
 		}
 	}
 	
-	// Check enum constraints
-	if enumValues, ok := propSchema["enum"].([]interface{}); ok {
+	// Check enum constraints, declared as []string by the embedded schemas and
+	// as []interface{} by decoded ones
+	switch enumValues := propSchema["enum"].(type) {
+	case []string:
+		if str, ok := value.(string); !ok || !slices.Contains(enumValues, str) {
+			return fmt.Errorf("value %v not in enum %v", value, enumValues)
+		}
+	case []interface{}:
 		found := false
 		for _, enumValue := range enumValues {
 			if value == enumValue {
@@ -439,19 +857,135 @@ func validateProperty(value interface{}, propSchema map[string]interface{}) erro
 	// Check string constraints
 	if str, ok := value.(string); ok {
 		if pattern, exists := propSchema["pattern"].(string); exists {
-			// Note: Full regex validation would require regexp package
-			if pattern == "^act_[a-zA-Z0-9_-]+$" && !IsValidActID(str) {
-				return fmt.Errorf("string %s does not match pattern %s", str, pattern)
+			re, err := v.pattern(pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %s: %w", pattern, err)
 			}
-			if pattern == "^conv_[a-zA-Z0-9_-]+$" && !IsValidConversationID(str) {
+			if !re.MatchString(str) {
 				return fmt.Errorf("string %s does not match pattern %s", str, pattern)
 			}
 		}
+		if format, exists := propSchema["format"].(string); exists {
+			if format == "regex" {
+				if _, err := regexp.Compile(str); err != nil {
+					return fmt.Errorf("string %s is not a valid regex: %w", str, err)
+				}
+			} else if formatType, known := schemaFormats[format]; known && !isValidFormat(formatType, str) {
+				return fmt.Errorf("string %s is not a valid %s", str, format)
+			}
+		}
+	}
+
+	// Descend into nested objects and check array length and items
+	if _, ok := value.(map[string]interface{}); ok {
+		if err := v.validate(value, Schema(propSchema)); err != nil {
+			return err
+		}
+	}
+	if items, ok := value.([]interface{}); ok {
+		if minItems, exists := toFloat64(propSchema["minItems"]); exists && float64(len(items)) < minItems {
+			return fmt.Errorf("array has %d items, fewer than minimum %v", len(items), minItems)
+		}
+		if maxItems, exists := toFloat64(propSchema["maxItems"]); exists && float64(len(items)) > maxItems {
+			return fmt.Errorf("array has %d items, more than maximum %v", len(items), maxItems)
+		}
+		if itemSchema, exists := propSchema["items"].(map[string]interface{}); exists {
+			for i, item := range items {
+				if err := v.validateProperty(item, itemSchema); err != nil {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+			}
+		}
 	}
 	
 	return nil
 }
 
+// validateOneOf checks that a value matches exactly one alternative schema.
+// When no alternative matches, the error from the single alternative the value
+// was evidently meant for is returned so that the message stays specific.
+func (v *schemaValidator) validateOneOf(value interface{}, branches []map[string]interface{}) error {
+	matches := 0
+	var candidates []error
+	for _, branch := range branches {
+		err := v.validateProperty(value, branch)
+		if err == nil {
+			matches++
+			continue
+		}
+		if v.fitsBranch(value, branch) {
+			candidates = append(candidates, err)
+		}
+	}
+
+	switch {
+	case matches == 1:
+		return nil
+	case matches > 1:
+		return fmt.Errorf("value matches %d oneOf schemas, expected exactly one", matches)
+	case len(candidates) == 1:
+		return candidates[0]
+	default:
+		return fmt.Errorf("value does not match any oneOf schema")
+	}
+}
+
+// fitsBranch reports whether a value has the shape an alternative schema
+// expects: a matching type and, for objects, a matching "type" discriminator
+func (v *schemaValidator) fitsBranch(value interface{}, branch map[string]interface{}) bool {
+	if ref, ok := branch["$ref"].(string); ok {
+		resolved, err := v.resolveRef(ref)
+		if err != nil {
+			return false
+		}
+		branch = resolved
+	}
+
+	if expectedType, ok := branch["type"].(string); ok && !validateType(value, expectedType) {
+		return false
+	}
+	dataMap, ok := value.(map[string]interface{})
+	if !ok {
+		return true
+	}
+	properties, _ := branch["properties"].(map[string]interface{})
+	discriminator, _ := properties["type"].(map[string]interface{})
+	if constValue, ok := discriminator["const"]; ok {
+		return dataMap["type"] == constValue
+	}
+	return true
+}
+
+// schemaBranches returns the alternatives listed by a oneOf keyword
+func schemaBranches(node interface{}) []map[string]interface{} {
+	switch n := node.(type) {
+	case []map[string]interface{}:
+		return n
+	case []interface{}:
+		branches := make([]map[string]interface{}, 0, len(n))
+		for _, child := range n {
+			if branch, ok := child.(map[string]interface{}); ok {
+				branches = append(branches, branch)
+			}
+		}
+		return branches
+	}
+	return nil
+}
+
+// schemaFormats maps the JSON Schema format keywords the validator checks to
+// the equivalent constraint formats. The regex format is checked by compiling
+// the value; other formats are treated as annotations and not enforced.
+var schemaFormats = map[string]FormatType{
+	"email":     FormatTypeEmail,
+	"uri":       FormatTypeURL,
+	"date-time": FormatTypeDateTime,
+	"date":      FormatTypeDate,
+	"uuid":      FormatTypeUUID,
+	"ipv4":      FormatTypeIPv4,
+	"ipv6":      FormatTypeIPv6,
+}
+
 // validateType checks if a value matches the expected JSON Schema type
 func validateType(value interface{}, expectedType string) bool {
 	switch expectedType {
@@ -569,6 +1103,23 @@ func ListSchemas() []string {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":                 "object",
 				"description":          "Additional context-specific metadata",
@@ -673,6 +1224,23 @@ func ListSchemas() []string {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":                 "object",
 				"description":          "Additional context-specific metadata",
@@ -782,6 +1350,23 @@ func ListSchemas() []string {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":                 "object",
 				"description":          "Additional context-specific metadata",
@@ -919,6 +1504,23 @@ func ListSchemas() []string {
 				"enum":        []string{"human", "speech_recognition", "text_analysis", "system", "ai"},
 				"description": "Source that generated this act",
 			},
+			"signature": map[string]interface{}{
+				"type":        "object",
+				"description": "Signature over the act's canonical form, for non-repudiation",
+				"required":    []string{"algorithm", "value"},
+				"properties": map[string]interface{}{
+					"algorithm": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"ed25519"},
+						"description": "Algorithm used to produce the signature",
+					},
+					"value": map[string]interface{}{
+						"type":        "string",
+						"description": "Signature bytes, base64 encoded",
+					},
+				},
+				"additionalProperties": false,
+			},
 			"metadata": map[string]interface{}{
 				"type":                 "object",
 				"description":          "Additional context-specific metadata",
@@ -0,0 +1,124 @@
+package astra
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// Act Signatures
+// ============================================================================
+
+// Sign signs the act's canonical form with an Ed25519 private key. Attach the
+// result as the act's Signature. Sign covers only the properties shared by
+// every act; when called on an Ask, Fact, Confirm, Commit, or Error through
+// the embedded Act, use SignAct instead so the signature also covers the
+// specialized properties.
+func (a Act) Sign(priv ed25519.PrivateKey) (ActSignature, error) {
+	return signCanonical(a, priv)
+}
+
+// VerifyActSignature checks the signature attached to an act against an
+// Ed25519 public key. It is the counterpart of Act.Sign; use VerifyAct for
+// acts signed with SignAct.
+func VerifyActSignature(a Act, pub ed25519.PublicKey) error {
+	return verifyCanonical(a, a, pub)
+}
+
+// SignAct signs the canonical form of any act, including its specialized
+// properties, with an Ed25519 private key. The act's own Signature is excluded
+// from what is signed, so the result can be attached to the act without
+// invalidating it.
+func SignAct(act ConversationAct, priv ed25519.PrivateKey) (ActSignature, error) {
+	if act == nil {
+		return ActSignature{}, fmt.Errorf("act cannot be nil")
+	}
+	return signCanonical(act, priv)
+}
+
+// VerifyAct checks the signature attached to an act against an Ed25519 public
+// key. Unsigned acts fail with ErrMissingField; signatures that do not match
+// the act's current canonical form, including any act modified after signing,
+// fail with ErrInvalidSignature.
+func VerifyAct(act ConversationAct, pub ed25519.PublicKey) error {
+	if act == nil {
+		return fmt.Errorf("act cannot be nil")
+	}
+	return verifyCanonical(act, act.GetAct(), pub)
+}
+
+// signCanonical signs the canonical form of an act
+func signCanonical(act interface{}, priv ed25519.PrivateKey) (ActSignature, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return ActSignature{}, fmt.Errorf("invalid ed25519 private key length: %d", len(priv))
+	}
+	message, err := canonicalActJSON(act)
+	if err != nil {
+		return ActSignature{}, err
+	}
+	return ActSignature{
+		Algorithm: SignatureAlgorithmEd25519,
+		Value:     base64.StdEncoding.EncodeToString(ed25519.Sign(priv, message)),
+	}, nil
+}
+
+// verifyCanonical checks the signature carried by an act's base properties
+// against the canonical form of the whole act
+func verifyCanonical(act interface{}, base Act, pub ed25519.PublicKey) error {
+	signature := base.Signature
+	if signature == nil {
+		return ValidationError{Field: "signature", Message: "act is not signed", Err: ErrMissingField}
+	}
+	if signature.Algorithm != SignatureAlgorithmEd25519 {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSignature, signature.Algorithm)
+	}
+	if len(pub) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid ed25519 public key length: %d", len(pub))
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature.Value)
+	if err != nil {
+		return fmt.Errorf("%w: signature is not valid base64: %v", ErrInvalidSignature, err)
+	}
+	message, err := canonicalActJSON(act)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(pub, message, sig) {
+		return fmt.Errorf("%w: act %s", ErrInvalidSignature, base.ID)
+	}
+	return nil
+}
+
+// canonicalActJSON returns the form of an act that signatures cover: its JSON
+// encoding without the signature property, with object keys sorted, no
+// insignificant whitespace, and the timestamp in UTC. Numbers keep the digits
+// they were encoded with. An act that round-trips through JSON or through
+// another encoding that preserves its values has the same canonical form.
+func canonicalActJSON(act interface{}) ([]byte, error) {
+	data, err := json.Marshal(act)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal act: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("failed to decode act: %w", err)
+	}
+
+	delete(fields, "signature")
+	if timestamp, ok := fields["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			fields["timestamp"] = t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+
+	// encoding/json writes map keys in sorted order
+	return json.Marshal(fields)
+}
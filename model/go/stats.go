@@ -0,0 +1,217 @@
+package astra
+
+import (
+	"math"
+	"sort"
+)
+
+// ============================================================================
+// Conversation Statistics
+// ============================================================================
+
+// ConversationStats is a read-only breakdown of a conversation computed from its
+// acts. Unlike ConversationMetadata it is never stored on the conversation.
+type ConversationStats struct {
+	// Total number of acts in the conversation
+	TotalActs int `json:"total_acts"`
+	// Number of acts of each type
+	ActsByType map[ActType]int `json:"acts_by_type"`
+	// Number of acts performed by each speaker
+	ActsBySpeaker map[string]int `json:"acts_by_speaker"`
+	// Number of distinct entities referenced by facts, confirms, and commits
+	UniqueEntities int `json:"unique_entities"`
+	// Total number of commits regardless of status
+	CommitCount int `json:"commit_count"`
+	// Number of commits with status success
+	SuccessfulCommits int `json:"successful_commits"`
+	// Number of commits with each status (commits without a status are not included)
+	CommitsByStatus map[CommitStatus]int `json:"commits_by_status"`
+	// Successful commits over total commits (0 when there are no commits)
+	CommitSuccessRate float64 `json:"commit_success_rate"`
+	// Total number of error acts
+	ErrorCount int `json:"error_count"`
+	// Number of error acts in each category (uncategorized errors are not included)
+	ErrorsByCategory map[ErrorCategory]int `json:"errors_by_category"`
+	// Average confidence across acts that carry a confidence score
+	AvgConfidence *float64 `json:"avg_confidence,omitempty"`
+	// Average processing_time_ms across acts that report it in their metadata
+	AvgProcessingTimeMs *float64 `json:"avg_processing_time_ms,omitempty"`
+}
+
+// Stats computes a ConversationStats breakdown of the conversation's acts
+func (c *Conversation) Stats() ConversationStats {
+	stats := ConversationStats{
+		TotalActs:        len(c.Acts),
+		ActsByType:       make(map[ActType]int),
+		ActsBySpeaker:    make(map[string]int),
+		CommitsByStatus:  make(map[CommitStatus]int),
+		ErrorsByCategory: make(map[ErrorCategory]int),
+	}
+
+	entities := make(map[string]struct{})
+	var totalConfidence, totalProcessingTime float64
+	confidenceCount, processingTimeCount := 0, 0
+
+	for _, act := range c.Acts {
+		baseAct := act.GetAct()
+		stats.ActsByType[act.GetType()]++
+		stats.ActsBySpeaker[baseAct.Speaker]++
+
+		if entityID, ok := actEntityID(act); ok {
+			entities[entityID] = struct{}{}
+		}
+
+		switch a := act.(type) {
+		case Commit:
+			stats.CommitCount++
+			if a.Status != nil {
+				stats.CommitsByStatus[*a.Status]++
+			}
+		case Error:
+			stats.ErrorCount++
+			if a.Category != nil {
+				stats.ErrorsByCategory[*a.Category]++
+			}
+		}
+
+		if baseAct.Confidence != nil {
+			totalConfidence += *baseAct.Confidence
+			confidenceCount++
+		}
+		if baseAct.Metadata != nil && baseAct.Metadata.ProcessingTimeMs != nil {
+			totalProcessingTime += *baseAct.Metadata.ProcessingTimeMs
+			processingTimeCount++
+		}
+	}
+
+	stats.UniqueEntities = len(entities)
+
+	stats.SuccessfulCommits = stats.CommitsByStatus[CommitStatusSuccess]
+	stats.CommitSuccessRate = c.CommitSuccessRate()
+	if confidenceCount > 0 {
+		avgConfidence := totalConfidence / float64(confidenceCount)
+		stats.AvgConfidence = &avgConfidence
+	}
+	if processingTimeCount > 0 {
+		avgProcessingTime := totalProcessingTime / float64(processingTimeCount)
+		stats.AvgProcessingTimeMs = &avgProcessingTime
+	}
+
+	return stats
+}
+
+// ============================================================================
+// Processing Latency
+// ============================================================================
+
+// LatencyStats summarizes the processing_time_ms reported in act metadata.
+// Percentiles use the nearest-rank method: the p-th percentile of n sorted
+// samples is the sample at rank ceil(p/100 * n), so it is always one of the
+// reported values rather than an interpolation between two of them.
+type LatencyStats struct {
+	// Number of acts that report a processing time; the other fields are zero
+	// when it is zero
+	Count int `json:"count"`
+	// Shortest processing time in milliseconds
+	MinMs float64 `json:"min_ms"`
+	// Longest processing time in milliseconds
+	MaxMs float64 `json:"max_ms"`
+	// Mean processing time in milliseconds
+	MeanMs float64 `json:"mean_ms"`
+	// Median processing time in milliseconds, by nearest rank
+	P50Ms float64 `json:"p50_ms"`
+	// 95th percentile processing time in milliseconds, by nearest rank
+	P95Ms float64 `json:"p95_ms"`
+}
+
+// ProcessingLatency computes LatencyStats over the acts whose metadata sets
+// processing_time_ms. Acts without it are ignored.
+func (c *Conversation) ProcessingLatency() LatencyStats {
+	var samples []float64
+	for _, act := range c.Acts {
+		metadata := act.GetAct().Metadata
+		if metadata != nil && metadata.ProcessingTimeMs != nil {
+			samples = append(samples, *metadata.ProcessingTimeMs)
+		}
+	}
+	if len(samples) == 0 {
+		return LatencyStats{}
+	}
+
+	sort.Float64s(samples)
+	var total float64
+	for _, sample := range samples {
+		total += sample
+	}
+
+	return LatencyStats{
+		Count:  len(samples),
+		MinMs:  samples[0],
+		MaxMs:  samples[len(samples)-1],
+		MeanMs: total / float64(len(samples)),
+		P50Ms:  nearestRank(samples, 50),
+		P95Ms:  nearestRank(samples, 95),
+	}
+}
+
+// nearestRank returns the p-th percentile of sorted, which must not be empty
+func nearestRank(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// ============================================================================
+// Metrics Snapshot
+// ============================================================================
+
+// Metric names produced by MetricsSnapshot
+const (
+	MetricActsTotal           = "astra_acts_total"
+	MetricErrorsTotal         = "astra_errors_total"
+	MetricCommitsTotal        = "astra_commits_total"
+	MetricCommitsSuccessTotal = "astra_commits_success_total"
+	MetricCommitSuccessRate   = "astra_commit_success_rate"
+	MetricUniqueEntities      = "astra_unique_entities"
+	MetricAvgConfidence       = "astra_avg_confidence"
+	MetricAvgProcessingTimeMs = "astra_avg_processing_time_ms"
+)
+
+// MetricsSnapshot flattens Stats() into metric name/value pairs that can be fed
+// to any metrics system. Per-type act counts are reported as
+// astra_acts_<type>_total, per-status commit counts as
+// astra_commits_<status>_total, and per-category error counts as
+// astra_errors_<category>_total. Averages are only present when the
+// conversation has acts that report them.
+func (c *Conversation) MetricsSnapshot() map[string]float64 {
+	stats := c.Stats()
+
+	snapshot := make(map[string]float64, 8+len(stats.ActsByType)+len(stats.CommitsByStatus)+len(stats.ErrorsByCategory))
+	snapshot[MetricActsTotal] = float64(stats.TotalActs)
+	snapshot[MetricErrorsTotal] = float64(stats.ErrorCount)
+	snapshot[MetricCommitsTotal] = float64(stats.CommitCount)
+	snapshot[MetricCommitsSuccessTotal] = float64(stats.SuccessfulCommits)
+	snapshot[MetricCommitSuccessRate] = stats.CommitSuccessRate
+	snapshot[MetricUniqueEntities] = float64(stats.UniqueEntities)
+
+	for actType, count := range stats.ActsByType {
+		snapshot["astra_acts_"+string(actType)+"_total"] = float64(count)
+	}
+	for status, count := range stats.CommitsByStatus {
+		snapshot["astra_commits_"+string(status)+"_total"] = float64(count)
+	}
+	for category, count := range stats.ErrorsByCategory {
+		snapshot["astra_errors_"+string(category)+"_total"] = float64(count)
+	}
+
+	if stats.AvgConfidence != nil {
+		snapshot[MetricAvgConfidence] = *stats.AvgConfidence
+	}
+	if stats.AvgProcessingTimeMs != nil {
+		snapshot[MetricAvgProcessingTimeMs] = *stats.AvgProcessingTimeMs
+	}
+
+	return snapshot
+}
@@ -0,0 +1,141 @@
+package astra
+
+import (
+	"sort"
+	"sync"
+)
+
+// ============================================================================
+// In-Memory Store
+// ============================================================================
+
+// Store holds conversations in memory by ID, with indexes from participant
+// IDs and referenced entity IDs to the conversations that contain them. It is
+// a convenience for services and tests, not a persistence engine. Store is
+// safe for concurrent use; conversations are copied on the way in and out, so
+// callers can modify what they pass to Put or receive from Get freely.
+type Store struct {
+	mu            sync.RWMutex
+	conversations map[string]Conversation
+	participants  map[string]map[string]struct{}
+	entities      map[string]map[string]struct{}
+}
+
+// NewStore creates an empty Store
+func NewStore() *Store {
+	return &Store{
+		conversations: make(map[string]Conversation),
+		participants:  make(map[string]map[string]struct{}),
+		entities:      make(map[string]map[string]struct{}),
+	}
+}
+
+// Put adds a conversation, replacing any stored conversation with the same
+// ID. Index entries of the replaced conversation are removed first, so
+// participants and entities it no longer contains stop pointing at it.
+func (s *Store) Put(c Conversation) {
+	c = c.Clone()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if previous, ok := s.conversations[c.ID]; ok {
+		s.unindex(previous)
+	}
+	s.conversations[c.ID] = c
+	s.index(c)
+}
+
+// Get returns a copy of the conversation with the given ID
+func (s *Store) Get(id string) (Conversation, bool) {
+	s.mu.RLock()
+	c, ok := s.conversations[id]
+	s.mu.RUnlock()
+	if !ok {
+		return Conversation{}, false
+	}
+	return c.Clone(), true
+}
+
+// Delete removes the conversation with the given ID and its index entries,
+// reporting whether it was present
+func (s *Store) Delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.conversations[id]
+	if !ok {
+		return false
+	}
+	s.unindex(c)
+	delete(s.conversations, id)
+	return true
+}
+
+// ByParticipant returns the IDs of the conversations listing a participant,
+// sorted
+func (s *Store) ByParticipant(participantID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedIDs(s.participants[participantID])
+}
+
+// ByEntity returns the IDs of the conversations whose facts, confirms, or
+// commits reference an entity, sorted
+func (s *Store) ByEntity(entityID string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedIDs(s.entities[entityID])
+}
+
+// index adds a conversation's index entries. The caller must hold the lock.
+func (s *Store) index(c Conversation) {
+	for _, participant := range c.Participants {
+		addToIndex(s.participants, participant.ID, c.ID)
+	}
+	for _, entityID := range c.EntitiesReferenced() {
+		addToIndex(s.entities, entityID, c.ID)
+	}
+}
+
+// unindex removes a conversation's index entries. The caller must hold the
+// lock.
+func (s *Store) unindex(c Conversation) {
+	for _, participant := range c.Participants {
+		removeFromIndex(s.participants, participant.ID, c.ID)
+	}
+	for _, entityID := range c.EntitiesReferenced() {
+		removeFromIndex(s.entities, entityID, c.ID)
+	}
+}
+
+// addToIndex records that the conversation contains key
+func addToIndex(index map[string]map[string]struct{}, key, conversationID string) {
+	ids, ok := index[key]
+	if !ok {
+		ids = make(map[string]struct{})
+		index[key] = ids
+	}
+	ids[conversationID] = struct{}{}
+}
+
+// removeFromIndex removes the conversation from key's entry, dropping the
+// entry once it is empty
+func removeFromIndex(index map[string]map[string]struct{}, key, conversationID string) {
+	ids, ok := index[key]
+	if !ok {
+		return
+	}
+	delete(ids, conversationID)
+	if len(ids) == 0 {
+		delete(index, key)
+	}
+}
+
+// sortedIDs returns the members of a set of IDs in sorted order
+func sortedIDs(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
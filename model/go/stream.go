@@ -0,0 +1,344 @@
+package astra
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ============================================================================
+// Streaming Decoding
+// ============================================================================
+
+// DecodeConversationStream decodes a conversation JSON document from r without
+// buffering its acts. Every other field is decoded into the returned
+// Conversation, while each element of the "acts" array is decoded and passed to
+// handler as soon as it is read. The returned Conversation's Acts is always nil;
+// callers that need the acts must collect them in the handler. Decoding stops at
+// the first error returned by handler, which is returned wrapped with the act's
+// index.
+func DecodeConversationStream(r io.Reader, handler func(ConversationAct) error) (Conversation, error) {
+	var c Conversation
+	if handler == nil {
+		return c, fmt.Errorf("act handler is required")
+	}
+
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return c, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return c, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return c, fmt.Errorf("invalid conversation JSON: unexpected token %v", token)
+		}
+
+		if key != "acts" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return c, fmt.Errorf("failed to decode %s: %w", key, err)
+			}
+			fields[key] = raw
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return c, fmt.Errorf("invalid acts: %w", err)
+		}
+		for i := 0; dec.More(); i++ {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return c, fmt.Errorf("failed to decode act at index %d: %w", i, err)
+			}
+			act, err := UnmarshalAct(raw)
+			if err != nil {
+				return c, fmt.Errorf("failed to unmarshal act at index %d: %w", i, err)
+			}
+			if err := handler(act); err != nil {
+				return c, fmt.Errorf("act handler failed at index %d: %w", i, err)
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return c, fmt.Errorf("invalid acts: %w", err)
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return c, err
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, err
+	}
+	c.Acts = nil
+	return c, nil
+}
+
+// expectDelim reads the next token and checks that it is the given delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("invalid conversation JSON: expected %v but found %v", delim, token)
+	}
+	return nil
+}
+
+// ============================================================================
+// JSON Lines
+// ============================================================================
+
+// A conversation in JSON Lines form, as written by WriteConversationJSONL and
+// read by LoadConversationJSONL, is a header line followed by one line per
+// act. The header is a JSON object holding every conversation field except
+// "acts" (id, participants, status, metadata and so on). Each following line
+// is a single act object carrying its own "type", in conversation order. Blank
+// lines are ignored, so an append-only log of acts can follow the header
+// directly.
+
+// WriteConversationJSONL writes a conversation to w in JSON Lines form: a
+// header line with every field but the acts, then one line per act
+func WriteConversationJSONL(w io.Writer, c Conversation) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+	var header map[string]json.RawMessage
+	if err := json.Unmarshal(data, &header); err != nil {
+		return fmt.Errorf("failed to marshal conversation: %w", err)
+	}
+	delete(header, "acts")
+
+	bw := bufio.NewWriter(w)
+	if err := writeJSONLine(bw, header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+	for i, act := range c.Acts {
+		if err := writeJSONLine(bw, act); err != nil {
+			return fmt.Errorf("failed to write act at index %d: %w", i, err)
+		}
+	}
+	return bw.Flush()
+}
+
+// writeJSONLine writes a value as compact JSON followed by a newline
+func writeJSONLine(w *bufio.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
+
+// LoadConversationJSONL reads a conversation in JSON Lines form from r: the
+// header line, then each act line decoded with UnmarshalAct. The acts are
+// appended in order and the metadata summary (act count, error and commit
+// counts, average confidence) is recomputed from them. Errors name the line
+// they occurred on. The conversation is not validated.
+func LoadConversationJSONL(r io.Reader) (Conversation, error) {
+	var c Conversation
+	br := bufio.NewReader(r)
+	headerRead := false
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, readErr := br.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return Conversation{}, fmt.Errorf("line %d: %w", lineNumber, readErr)
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if !headerRead {
+				if err := decodeJSONLHeader(line, &c); err != nil {
+					return Conversation{}, fmt.Errorf("line %d: %w", lineNumber, err)
+				}
+				headerRead = true
+			} else {
+				act, err := UnmarshalAct(line)
+				if err != nil {
+					return Conversation{}, fmt.Errorf("line %d: %w", lineNumber, err)
+				}
+				c.Acts = append(c.Acts, act)
+			}
+		}
+
+		if readErr != nil {
+			break
+		}
+	}
+
+	if !headerRead {
+		return Conversation{}, fmt.Errorf("missing conversation header line")
+	}
+	c.updateMetadata()
+	return c, nil
+}
+
+// decodeJSONLHeader decodes the header line of a JSON Lines conversation
+func decodeJSONLHeader(line []byte, c *Conversation) error {
+	var header map[string]json.RawMessage
+	if err := json.Unmarshal(line, &header); err != nil {
+		return fmt.Errorf("invalid conversation header: %w", err)
+	}
+	if _, ok := header["acts"]; ok {
+		return fmt.Errorf("invalid conversation header: acts belong on their own lines")
+	}
+	if err := json.Unmarshal(line, c); err != nil {
+		return fmt.Errorf("invalid conversation header: %w", err)
+	}
+	c.Acts = nil
+	return nil
+}
+
+// ============================================================================
+// Length-Prefixed Frames
+// ============================================================================
+
+// Each frame written by FrameWriter and read by FrameReader is a 4-byte
+// big-endian payload length followed by that many bytes of act JSON. Unlike
+// JSON Lines, framing does not depend on the payload, which suits binary
+// channels such as raw sockets.
+
+const (
+	// frameHeaderSize is the size of the length prefix of a frame
+	frameHeaderSize = 4
+	// DefaultMaxFrameSize bounds the payload of a frame unless
+	// WithMaxFrameSize says otherwise
+	DefaultMaxFrameSize = 4 << 20
+)
+
+// frameConfig holds the settings shared by FrameWriter and FrameReader
+type frameConfig struct {
+	maxFrameSize int
+}
+
+// FrameOption is a function type for configuring FrameWriter and FrameReader creation
+type FrameOption func(*frameConfig)
+
+// WithMaxFrameSize sets the largest payload, in bytes, that is written or
+// accepted. Sizes of zero or less, and sizes beyond what the length prefix can
+// express, are ignored.
+func WithMaxFrameSize(size int) FrameOption {
+	return func(c *frameConfig) {
+		if size > 0 && uint64(size) <= 0xFFFFFFFF {
+			c.maxFrameSize = size
+		}
+	}
+}
+
+// newFrameConfig applies options to the default frame settings
+func newFrameConfig(options []FrameOption) frameConfig {
+	config := frameConfig{maxFrameSize: DefaultMaxFrameSize}
+	for _, option := range options {
+		option(&config)
+	}
+	return config
+}
+
+// FrameWriter writes acts to an io.Writer as length-prefixed frames. It is
+// not safe for concurrent use.
+type FrameWriter struct {
+	w      io.Writer
+	config frameConfig
+}
+
+// NewFrameWriter creates a FrameWriter writing to w
+func NewFrameWriter(w io.Writer, options ...FrameOption) *FrameWriter {
+	return &FrameWriter{w: w, config: newFrameConfig(options)}
+}
+
+// WriteAct writes an act as one frame. The length prefix and payload are
+// passed to the underlying writer in a single Write. An act whose JSON
+// exceeds the maximum frame size is not written, and the error matches
+// ErrFrameTooLarge.
+func (fw *FrameWriter) WriteAct(act ConversationAct) error {
+	data, err := MarshalAct(act)
+	if err != nil {
+		return fmt.Errorf("failed to marshal act: %w", err)
+	}
+	if len(data) > fw.config.maxFrameSize {
+		return fmt.Errorf("act of %d bytes exceeds %d: %w", len(data), fw.config.maxFrameSize, ErrFrameTooLarge)
+	}
+
+	frame := make([]byte, frameHeaderSize+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[frameHeaderSize:], data)
+	_, err = fw.w.Write(frame)
+	return err
+}
+
+// FrameReader reads acts written by FrameWriter from an io.Reader. It is not
+// safe for concurrent use.
+type FrameReader struct {
+	r      io.Reader
+	config frameConfig
+	err    error
+}
+
+// NewFrameReader creates a FrameReader reading from r
+func NewFrameReader(r io.Reader, options ...FrameOption) *FrameReader {
+	return &FrameReader{r: r, config: newFrameConfig(options)}
+}
+
+// ReadAct reads the next frame and decodes its act with UnmarshalAct. At the
+// end of the stream, when no bytes of a further frame have been read, it
+// returns io.EOF itself; a stream ending partway through a frame yields an
+// error matching io.ErrUnexpectedEOF. A length prefix above the maximum frame
+// size yields an error matching ErrFrameTooLarge, before any of the payload
+// is allocated or read.
+//
+// Once the stream is out of step with the frames, because of a read error or
+// an oversized frame, every later call returns the same error. An act that
+// fails to decode leaves the reader at the next frame.
+func (fr *FrameReader) ReadAct() (ConversationAct, error) {
+	if fr.err != nil {
+		return nil, fr.err
+	}
+
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(fr.r, header[:]); err != nil {
+		if !errors.Is(err, io.EOF) {
+			err = fmt.Errorf("failed to read frame header: %w", err)
+		}
+		fr.err = err
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if uint64(size) > uint64(fr.config.maxFrameSize) {
+		fr.err = fmt.Errorf("frame of %d bytes exceeds %d: %w", size, fr.config.maxFrameSize, ErrFrameTooLarge)
+		return nil, fr.err
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(fr.r, payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		fr.err = fmt.Errorf("failed to read frame of %d bytes: %w", size, err)
+		return nil, fr.err
+	}
+
+	act, err := UnmarshalAct(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal framed act: %w", err)
+	}
+	return act, nil
+}
@@ -0,0 +1,184 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Transcript Rendering
+// ============================================================================
+
+// DefaultTranscriptTimeFormat is the timestamp layout used in transcripts
+const DefaultTranscriptTimeFormat = "15:04:05"
+
+// TranscriptOptions controls how Conversation.TranscriptWithOptions renders acts
+type TranscriptOptions struct {
+	// Append each act's confidence score when present
+	ShowConfidence bool
+	// Append each act's metadata (channel, language, and additional properties)
+	ShowMetadata bool
+	// Time zone for timestamps. When nil, the conversation context's "timezone"
+	// property is used if it names a valid location, otherwise timestamps are
+	// shown in their own location.
+	Location *time.Location
+	// Timestamp layout (defaults to DefaultTranscriptTimeFormat)
+	TimeFormat string
+}
+
+// Transcript renders the conversation as a plain-text transcript, one act per line
+func (c Conversation) Transcript() string {
+	return c.TranscriptWithOptions(TranscriptOptions{})
+}
+
+// TranscriptWithOptions renders the conversation as a plain-text transcript
+func (c Conversation) TranscriptWithOptions(opts TranscriptOptions) string {
+	location := opts.Location
+	if location == nil {
+		location = c.contextLocation()
+	}
+	timeFormat := opts.TimeFormat
+	if timeFormat == "" {
+		timeFormat = DefaultTranscriptTimeFormat
+	}
+
+	var b strings.Builder
+	for _, act := range c.Acts {
+		baseAct := act.GetAct()
+		timestamp := baseAct.Timestamp
+		if location != nil {
+			timestamp = timestamp.In(location)
+		}
+
+		fmt.Fprintf(&b, "[%s] %s (%s): %s", timestamp.Format(timeFormat), baseAct.Speaker, act.GetType(), describeAct(act))
+		if opts.ShowConfidence && baseAct.Confidence != nil {
+			fmt.Fprintf(&b, " (confidence %.2f)", *baseAct.Confidence)
+		}
+		if opts.ShowMetadata && baseAct.Metadata != nil {
+			if metadata := describeActMetadata(*baseAct.Metadata); metadata != "" {
+				fmt.Fprintf(&b, " {%s}", metadata)
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// contextLocation returns the time zone named by the conversation context's
+// "timezone" property, or nil if there is none or it is not a valid location
+func (c Conversation) contextLocation() *time.Location {
+	if c.Context == nil {
+		return nil
+	}
+	tz, ok := c.Context.AdditionalProperties["timezone"].(string)
+	if !ok {
+		return nil
+	}
+	location, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil
+	}
+	return location
+}
+
+// describeAct renders the body of a transcript line for an act
+func describeAct(act ConversationAct) string {
+	switch a := act.(type) {
+	case Ask:
+		return a.Prompt
+	case Fact:
+		target := transcriptEntity(a.Entity) + "." + a.Field
+		operation := FieldOperationSet
+		if a.Operation != nil {
+			operation = *a.Operation
+		}
+		switch operation {
+		case FieldOperationDelete:
+			return target + " deleted"
+		case FieldOperationAppend, FieldOperationIncrement:
+			return target + " += " + transcriptValue(a.Value)
+		case FieldOperationDecrement:
+			return target + " -= " + transcriptValue(a.Value)
+		case FieldOperationMerge:
+			return target + " merged with " + transcriptValue(a.Value)
+		default:
+			return target + " = " + transcriptValue(a.Value)
+		}
+	case Confirm:
+		status := "awaiting"
+		if a.Confirmed != nil {
+			if *a.Confirmed {
+				status = "confirmed"
+			} else {
+				status = "rejected"
+				if a.RejectionReason != nil {
+					status += ": " + *a.RejectionReason
+				}
+			}
+		}
+		return fmt.Sprintf("%s - %s [%s]", transcriptEntity(a.Entity), a.Summary, status)
+	case Commit:
+		line := fmt.Sprintf("%s %s", a.Action, transcriptEntity(a.Entity))
+		if a.System != nil {
+			line += " in " + *a.System
+		}
+		if a.Status != nil {
+			line += fmt.Sprintf(" [%s]", *a.Status)
+		}
+		if a.Error != nil {
+			line += fmt.Sprintf(" %s - %s", a.Error.Code, a.Error.Message)
+		}
+		return line
+	case Error:
+		return fmt.Sprintf("%s - %s", a.Code, a.Message)
+	default:
+		return ""
+	}
+}
+
+// describeActMetadata renders act metadata as sorted key=value pairs
+func describeActMetadata(metadata ActMetadata) string {
+	var pairs []string
+	if metadata.Channel != nil {
+		pairs = append(pairs, "channel="+*metadata.Channel)
+	}
+	if metadata.Language != nil {
+		pairs = append(pairs, "language="+*metadata.Language)
+	}
+	keys := make([]string, 0, len(metadata.AdditionalProperties))
+	for key := range metadata.AdditionalProperties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+transcriptValue(metadata.AdditionalProperties[key]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// transcriptEntity renders an entity reference by its ID
+func transcriptEntity(ref EntityRef) string {
+	if id, err := GetEntityID(ref); err == nil {
+		return id
+	}
+	if entity, err := NormalizeEntityRef(ref); err == nil && entity.ID != "" {
+		return entity.ID
+	}
+	return "?"
+}
+
+// transcriptValue renders a fact value; strings are shown as-is and other
+// values as JSON
+func transcriptValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
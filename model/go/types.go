@@ -2,7 +2,12 @@ package astra
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -49,50 +54,115 @@ type ActMetadata struct {
 // MarshalJSON implements custom JSON marshaling for ActMetadata
 func (m ActMetadata) MarshalJSON() ([]byte, error) {
 	type Alias ActMetadata
-	base := map[string]interface{}{
-		"channel":             m.Channel,
-		"language":            m.Language,
-		"original_text":       m.OriginalText,
-		"processing_time_ms":  m.ProcessingTimeMs,
+	return marshalWithAdditionalProperties(Alias(m), m.AdditionalProperties)
+}
+
+// marshalWithAdditionalProperties marshals the known fields of a value, leaving
+// out unset fields as their omitempty tags require, and adds its additional
+// properties alongside them. An additional property named like one of the
+// known fields is an error, whether or not that field is set, since it would
+// otherwise replace the field or be read back into it.
+func marshalWithAdditionalProperties(known interface{}, additional map[string]interface{}) ([]byte, error) {
+	if len(additional) == 0 {
+		return json.Marshal(known)
 	}
-	
-	// Add additional properties
-	for k, v := range m.AdditionalProperties {
+
+	reserved := jsonFieldNames(reflect.TypeOf(known))
+	for key := range additional {
+		if _, ok := reserved[key]; ok {
+			return nil, fmt.Errorf("additional property %q conflicts with a known field", key)
+		}
+	}
+
+	data, err := json.Marshal(known)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	base := make(map[string]interface{}, len(fields)+len(additional))
+	for k, v := range fields {
 		base[k] = v
 	}
-	
+	for k, v := range additional {
+		base[k] = v
+	}
+
 	return json.Marshal(base)
 }
 
-// UnmarshalJSON implements custom JSON unmarshaling for ActMetadata
-func (m *ActMetadata) UnmarshalJSON(data []byte) error {
-	type Alias ActMetadata
-	aux := &struct {
-		*Alias
-	}{
-		Alias: (*Alias)(m),
+// unmarshalWithAdditionalProperties decodes a JSON object into known, a
+// pointer to a struct without custom unmarshaling, and returns the members
+// that are not among its fields. The document is parsed once. Members match
+// fields by their exact JSON name, so a member such as "Channel" is kept as an
+// additional property instead of also setting the channel field through
+// encoding/json's case-insensitive matching.
+func unmarshalWithAdditionalProperties(data []byte, known interface{}) (map[string]interface{}, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
 	}
-	
-	var raw map[string]interface{}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
+
+	fields := jsonFieldNames(reflect.TypeOf(known).Elem())
+	knownMembers := make(map[string]json.RawMessage, len(members))
+	additional := make(map[string]interface{})
+	for key, value := range members {
+		if _, ok := fields[key]; ok {
+			knownMembers[key] = value
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, err
+		}
+		additional[key] = decoded
 	}
-	
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return err
+
+	knownData, err := json.Marshal(knownMembers)
+	if err != nil {
+		return nil, err
 	}
-	
-	// Extract additional properties
-	m.AdditionalProperties = make(map[string]interface{})
-	for k, v := range raw {
-		switch k {
-		case "channel", "language", "original_text", "processing_time_ms":
-			// Skip known fields
-		default:
-			m.AdditionalProperties[k] = v
+	if err := json.Unmarshal(knownData, known); err != nil {
+		return nil, err
+	}
+	return additional, nil
+}
+
+// jsonFieldNameCache holds the result of jsonFieldNames for each struct type
+var jsonFieldNameCache sync.Map
+
+// jsonFieldNames returns the JSON member names of a struct's fields
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	if names, ok := jsonFieldNameCache.Load(t); ok {
+		return names.(map[string]struct{})
+	}
+
+	names := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
 		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = struct{}{}
 	}
-	
+	jsonFieldNameCache.Store(t, names)
+	return names
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for ActMetadata
+func (m *ActMetadata) UnmarshalJSON(data []byte) error {
+	type Alias ActMetadata
+	additional, err := unmarshalWithAdditionalProperties(data, (*Alias)(m))
+	if err != nil {
+		return err
+	}
+	m.AdditionalProperties = additional
 	return nil
 }
 
@@ -112,6 +182,23 @@ type Act struct {
 	Source *Source `json:"source,omitempty"`
 	// Additional context-specific metadata
 	Metadata *ActMetadata `json:"metadata,omitempty"`
+	// Signature over the act's canonical form, for non-repudiation
+	Signature *ActSignature `json:"signature,omitempty"`
+}
+
+// SignatureAlgorithm identifies the algorithm of an act signature
+type SignatureAlgorithm string
+
+const (
+	SignatureAlgorithmEd25519 SignatureAlgorithm = "ed25519"
+)
+
+// ActSignature is a signature over an act's canonical form
+type ActSignature struct {
+	// Algorithm used to produce the signature
+	Algorithm SignatureAlgorithm `json:"algorithm"`
+	// Signature bytes, base64 encoded
+	Value string `json:"value"`
 }
 
 // GetAct implements ConversationAct interface
@@ -146,6 +233,63 @@ type Entity struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// Validate checks the entity against the schema: an ID accepted by
+// IsValidEntityID, a non-empty type, and, when set, a schema URL that parses
+// as an absolute URL
+func (e Entity) Validate() error {
+	if e.ID == "" {
+		return ValidationError{Field: "id", Message: "entity ID is required", Value: e.ID, Err: ErrMissingField}
+	}
+	if !IsValidEntityID(e.ID) {
+		return ValidationError{Field: "id", Message: "invalid entity ID format", Value: e.ID}
+	}
+	if e.Type == "" {
+		return ValidationError{Field: "type", Message: "entity type is required", Value: e.Type, Err: ErrMissingField}
+	}
+	if e.SchemaURL != nil {
+		if u, err := url.Parse(*e.SchemaURL); err != nil || !u.IsAbs() {
+			return ValidationError{Field: "schema_url", Message: "schema_url must be an absolute URL", Value: *e.SchemaURL}
+		}
+	}
+	return nil
+}
+
+// validateEntityRef validates the entity of a fact, confirm, or commit.
+// Structured references, including decoded JSON objects, must be valid
+// entities; string IDs and other references are left to the caller.
+func validateEntityRef(ref EntityRef) error {
+	var entity Entity
+	switch e := ref.(type) {
+	case nil:
+		return ValidationError{Field: "entity", Message: "entity is required", Value: ref, Err: ErrMissingField}
+	case *Entity:
+		if e == nil {
+			return ValidationError{Field: "entity", Message: "entity is required", Value: ref, Err: ErrMissingField}
+		}
+		entity = *e
+	case Entity:
+		entity = e
+	case map[string]interface{}:
+		normalized, err := NormalizeEntityRef(e)
+		if err != nil {
+			return ValidationError{Field: "entity", Message: err.Error(), Value: ref}
+		}
+		entity = normalized
+	default:
+		return nil
+	}
+
+	if err := entity.Validate(); err != nil {
+		var validationErr ValidationError
+		if errors.As(err, &validationErr) {
+			validationErr.Field = "entity." + validationErr.Field
+			return validationErr
+		}
+		return err
+	}
+	return nil
+}
+
 // EntityRef represents an entity reference that can be either a string ID or structured Entity
 type EntityRef interface{}
 
@@ -166,6 +310,57 @@ func GetEntityID(ref EntityRef) (string, error) {
 	}
 }
 
+// NormalizeEntityRef converts an EntityRef into a concrete Entity. A bare string
+// ID becomes an Entity with only its ID set, and a decoded JSON object is
+// converted field-by-field.
+func NormalizeEntityRef(ref EntityRef) (Entity, error) {
+	switch e := ref.(type) {
+	case string:
+		return Entity{ID: e}, nil
+	case Entity:
+		return e, nil
+	case *Entity:
+		if e == nil {
+			return Entity{}, fmt.Errorf("entity reference is nil")
+		}
+		return *e, nil
+	case map[string]interface{}:
+		data, err := json.Marshal(e)
+		if err != nil {
+			return Entity{}, fmt.Errorf("invalid entity reference: %w", err)
+		}
+		var entity Entity
+		if err := json.Unmarshal(data, &entity); err != nil {
+			return Entity{}, fmt.Errorf("invalid entity reference: %w", err)
+		}
+		return entity, nil
+	default:
+		return Entity{}, fmt.Errorf("invalid entity reference type: %T", ref)
+	}
+}
+
+// actEntityID resolves the entity referenced by a Fact, Confirm, or Commit.
+// Asks and errors do not reference an entity.
+func actEntityID(act ConversationAct) (string, bool) {
+	var ref EntityRef
+	switch a := act.(type) {
+	case Fact:
+		ref = a.Entity
+	case Confirm:
+		ref = a.Entity
+	case Commit:
+		ref = a.Entity
+	default:
+		return "", false
+	}
+
+	id, err := GetEntityID(ref)
+	if err != nil || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
 // ============================================================================
 // Constraint Types
 // ============================================================================
@@ -217,6 +412,16 @@ type Constraint struct {
 	Message *string `json:"message,omitempty"`
 	// Machine-readable error code for constraint violations
 	Code *string `json:"code,omitempty"`
+
+	// builderMessage records the catalog message a constraint builder set as
+	// Message, so that it can be rendered again in another locale
+	builderMessage *builderMessage
+}
+
+// builderMessage is a catalog message and the text it was rendered to
+type builderMessage struct {
+	key  MessageKey
+	text string
 }
 
 // ============================================================================
@@ -247,48 +452,18 @@ type ParticipantPreferences struct {
 
 // MarshalJSON implements custom JSON marshaling for ParticipantPreferences
 func (p ParticipantPreferences) MarshalJSON() ([]byte, error) {
-	base := map[string]interface{}{
-		"language":               p.Language,
-		"timezone":               p.Timezone,
-		"communication_channels": p.CommunicationChannels,
-	}
-	
-	for k, v := range p.AdditionalProperties {
-		base[k] = v
-	}
-	
-	return json.Marshal(base)
+	type Alias ParticipantPreferences
+	return marshalWithAdditionalProperties(Alias(p), p.AdditionalProperties)
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for ParticipantPreferences
 func (p *ParticipantPreferences) UnmarshalJSON(data []byte) error {
 	type Alias ParticipantPreferences
-	aux := &struct {
-		*Alias
-	}{
-		Alias: (*Alias)(p),
-	}
-	
-	var raw map[string]interface{}
-	if err := json.Unmarshal(data, &raw); err != nil {
+	additional, err := unmarshalWithAdditionalProperties(data, (*Alias)(p))
+	if err != nil {
 		return err
 	}
-	
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return err
-	}
-	
-	// Extract additional properties
-	p.AdditionalProperties = make(map[string]interface{})
-	for k, v := range raw {
-		switch k {
-		case "language", "timezone", "communication_channels":
-			// Skip known fields
-		default:
-			p.AdditionalProperties[k] = v
-		}
-	}
-	
+	p.AdditionalProperties = additional
 	return nil
 }
 
@@ -361,10 +536,10 @@ type Ask struct {
 // Validate implements ConversationAct interface
 func (a Ask) Validate() error {
 	if a.Field == "" {
-		return ValidationError{Field: "field", Message: "field is required", Value: a.Field}
+		return ValidationError{Field: "field", Message: "field is required", Value: a.Field, Err: ErrMissingField}
 	}
 	if a.Prompt == "" {
-		return ValidationError{Field: "prompt", Message: "prompt is required", Value: a.Prompt}
+		return ValidationError{Field: "prompt", Message: "prompt is required", Value: a.Prompt, Err: ErrMissingField}
 	}
 	if a.RetryCount != nil && *a.RetryCount < 0 {
 		return ValidationError{Field: "retry_count", Message: "retry_count cannot be negative", Value: *a.RetryCount}
@@ -416,16 +591,43 @@ type Fact struct {
 	ValidationErrors []string `json:"validation_errors,omitempty"`
 }
 
+// EntityRef returns the referenced entity in normalized form
+func (f Fact) EntityRef() (Entity, error) {
+	return NormalizeEntityRef(f.Entity)
+}
+
+// SetEntity sets the fact's entity reference to a structured Entity
+func (f *Fact) SetEntity(e Entity) {
+	f.Entity = e
+}
+
+// SetEntityID sets the fact's entity reference to the structured form of a
+// bare entity ID, an Entity with only its ID set, as NormalizeEntityRef
+// produces for a string reference
+func (f *Fact) SetEntityID(id string) {
+	f.Entity = Entity{ID: id}
+}
+
+// IsNoOp reports whether the fact sets a field to the value it already had,
+// according to PreviousValue. Facts with other operations or without a
+// previous value are never no-ops.
+func (f Fact) IsNoOp() bool {
+	if f.Operation != nil && *f.Operation != FieldOperationSet {
+		return false
+	}
+	return f.PreviousValue != nil && ValuesEqual(f.Value, f.PreviousValue)
+}
+
 // Validate implements ConversationAct interface
 func (f Fact) Validate() error {
-	if f.Entity == nil {
-		return ValidationError{Field: "entity", Message: "entity is required", Value: f.Entity}
+	if err := validateEntityRef(f.Entity); err != nil {
+		return err
 	}
 	if f.Field == "" {
-		return ValidationError{Field: "field", Message: "field is required", Value: f.Field}
+		return ValidationError{Field: "field", Message: "field is required", Value: f.Field, Err: ErrMissingField}
 	}
 	if f.Value == nil {
-		return ValidationError{Field: "value", Message: "value is required", Value: f.Value}
+		return ValidationError{Field: "value", Message: "value is required", Value: f.Value, Err: ErrMissingField}
 	}
 	return nil
 }
@@ -462,17 +664,32 @@ type Confirm struct {
 	TimeoutMs *int64 `json:"timeout_ms,omitempty"`
 }
 
+// EntityRef returns the referenced entity in normalized form
+func (c Confirm) EntityRef() (Entity, error) {
+	return NormalizeEntityRef(c.Entity)
+}
+
 // Validate implements ConversationAct interface
 func (c Confirm) Validate() error {
-	if c.Entity == nil {
-		return ValidationError{Field: "entity", Message: "entity is required", Value: c.Entity}
+	if err := validateEntityRef(c.Entity); err != nil {
+		return err
 	}
 	if c.Summary == "" {
-		return ValidationError{Field: "summary", Message: "summary is required", Value: c.Summary}
+		return ValidationError{Field: "summary", Message: "summary is required", Value: c.Summary, Err: ErrMissingField}
 	}
 	if c.TimeoutMs != nil && *c.TimeoutMs < 0 {
 		return ValidationError{Field: "timeout_ms", Message: "timeout_ms cannot be negative", Value: *c.TimeoutMs}
 	}
+	if c.Awaiting != nil && *c.Awaiting && c.Confirmed != nil {
+		return ValidationError{Field: "confirmed", Message: "confirmed must not be set while awaiting confirmation", Value: *c.Confirmed}
+	}
+	if c.Confirmed != nil && !*c.Confirmed && (c.RejectionReason == nil || *c.RejectionReason == "") {
+		return ValidationError{Field: "rejection_reason", Message: "rejection_reason is required when confirmation is rejected", Value: c.RejectionReason, Err: ErrMissingField}
+	}
+	// awaiting defaults to true, so a timed-out confirmation must clear it explicitly
+	if c.ConfirmationMethod != nil && *c.ConfirmationMethod == ConfirmationMethodTimeout && (c.Awaiting == nil || *c.Awaiting) {
+		return ValidationError{Field: "awaiting", Message: "awaiting must be false when confirmation method is timeout", Value: c.Awaiting}
+	}
 	return nil
 }
 
@@ -538,13 +755,18 @@ type Commit struct {
 	RollbackInfo map[string]interface{} `json:"rollback_info,omitempty"`
 }
 
+// EntityRef returns the referenced entity in normalized form
+func (c Commit) EntityRef() (Entity, error) {
+	return NormalizeEntityRef(c.Entity)
+}
+
 // Validate implements ConversationAct interface
 func (c Commit) Validate() error {
-	if c.Entity == nil {
-		return ValidationError{Field: "entity", Message: "entity is required", Value: c.Entity}
+	if err := validateEntityRef(c.Entity); err != nil {
+		return err
 	}
 	if c.Action == "" {
-		return ValidationError{Field: "action", Message: "action is required", Value: c.Action}
+		return ValidationError{Field: "action", Message: "action is required", Value: c.Action, Err: ErrMissingField}
 	}
 	if c.RetryCount != nil && *c.RetryCount < 0 {
 		return ValidationError{Field: "retry_count", Message: "retry_count cannot be negative", Value: *c.RetryCount}
@@ -552,6 +774,24 @@ func (c Commit) Validate() error {
 	if c.MaxRetries != nil && *c.MaxRetries < 0 {
 		return ValidationError{Field: "max_retries", Message: "max_retries cannot be negative", Value: *c.MaxRetries}
 	}
+	if c.Status != nil {
+		switch *c.Status {
+		case CommitStatusFailed, CommitStatusRetrying:
+			if c.Error == nil {
+				return ValidationError{Field: "error", Message: fmt.Sprintf("error is required when status is %s", *c.Status), Value: c.Error, Err: ErrMissingField}
+			}
+			if c.Error.Code == "" {
+				return ValidationError{Field: "error.code", Message: "error code is required", Value: c.Error.Code, Err: ErrMissingField}
+			}
+			if c.Error.Message == "" {
+				return ValidationError{Field: "error.message", Message: "error message is required", Value: c.Error.Message, Err: ErrMissingField}
+			}
+		case CommitStatusSuccess:
+			if c.Error != nil {
+				return ValidationError{Field: "error", Message: "error must not be set when status is success", Value: c.Error}
+			}
+		}
+	}
 	return nil
 }
 
@@ -619,10 +859,10 @@ type Error struct {
 // Validate implements ConversationAct interface
 func (e Error) Validate() error {
 	if e.Code == "" {
-		return ValidationError{Field: "code", Message: "code is required", Value: e.Code}
+		return ValidationError{Field: "code", Message: "code is required", Value: e.Code, Err: ErrMissingField}
 	}
 	if e.Message == "" {
-		return ValidationError{Field: "message", Message: "message is required", Value: e.Message}
+		return ValidationError{Field: "message", Message: "message is required", Value: e.Message, Err: ErrMissingField}
 	}
 	if e.RelatedActID != nil && !IsValidActID(*e.RelatedActID) {
 		return ValidationError{Field: "related_act_id", Message: "invalid act ID format", Value: *e.RelatedActID}
@@ -661,49 +901,18 @@ type ConversationContext struct {
 
 // MarshalJSON implements custom JSON marshaling for ConversationContext
 func (c ConversationContext) MarshalJSON() ([]byte, error) {
-	base := map[string]interface{}{
-		"session_id": c.SessionID,
-		"user_agent": c.UserAgent,
-		"ip_address": c.IPAddress,
-		"referrer":   c.Referrer,
-	}
-	
-	for k, v := range c.AdditionalProperties {
-		base[k] = v
-	}
-	
-	return json.Marshal(base)
+	type Alias ConversationContext
+	return marshalWithAdditionalProperties(Alias(c), c.AdditionalProperties)
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for ConversationContext
 func (c *ConversationContext) UnmarshalJSON(data []byte) error {
 	type Alias ConversationContext
-	aux := &struct {
-		*Alias
-	}{
-		Alias: (*Alias)(c),
-	}
-	
-	var raw map[string]interface{}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
-	}
-	
-	if err := json.Unmarshal(data, &aux); err != nil {
+	additional, err := unmarshalWithAdditionalProperties(data, (*Alias)(c))
+	if err != nil {
 		return err
 	}
-	
-	// Extract additional properties
-	c.AdditionalProperties = make(map[string]interface{})
-	for k, v := range raw {
-		switch k {
-		case "session_id", "user_agent", "ip_address", "referrer":
-			// Skip known fields
-		default:
-			c.AdditionalProperties[k] = v
-		}
-	}
-	
+	c.AdditionalProperties = additional
 	return nil
 }
 
@@ -725,50 +934,18 @@ type ConversationMetadata struct {
 
 // MarshalJSON implements custom JSON marshaling for ConversationMetadata
 func (m ConversationMetadata) MarshalJSON() ([]byte, error) {
-	base := map[string]interface{}{
-		"total_duration_ms": m.TotalDurationMs,
-		"act_count":         m.ActCount,
-		"error_count":       m.ErrorCount,
-		"commit_count":      m.CommitCount,
-		"avg_confidence":    m.AvgConfidence,
-	}
-	
-	for k, v := range m.AdditionalProperties {
-		base[k] = v
-	}
-	
-	return json.Marshal(base)
+	type Alias ConversationMetadata
+	return marshalWithAdditionalProperties(Alias(m), m.AdditionalProperties)
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for ConversationMetadata
 func (m *ConversationMetadata) UnmarshalJSON(data []byte) error {
 	type Alias ConversationMetadata
-	aux := &struct {
-		*Alias
-	}{
-		Alias: (*Alias)(m),
-	}
-	
-	var raw map[string]interface{}
-	if err := json.Unmarshal(data, &raw); err != nil {
+	additional, err := unmarshalWithAdditionalProperties(data, (*Alias)(m))
+	if err != nil {
 		return err
 	}
-	
-	if err := json.Unmarshal(data, &aux); err != nil {
-		return err
-	}
-	
-	// Extract additional properties
-	m.AdditionalProperties = make(map[string]interface{})
-	for k, v := range raw {
-		switch k {
-		case "total_duration_ms", "act_count", "error_count", "commit_count", "avg_confidence":
-			// Skip known fields
-		default:
-			m.AdditionalProperties[k] = v
-		}
-	}
-	
+	m.AdditionalProperties = additional
 	return nil
 }
 
@@ -776,6 +953,8 @@ func (m *ConversationMetadata) UnmarshalJSON(data []byte) error {
 type Conversation struct {
 	// Unique identifier for this conversation
 	ID string `json:"id"`
+	// ASTRA schema version this conversation was written against
+	SchemaVersion *string `json:"schema_version,omitempty"`
 	// List of conversation participants
 	Participants []Participant `json:"participants"`
 	// Ordered sequence of acts in this conversation
@@ -794,22 +973,28 @@ type Conversation struct {
 	Context *ConversationContext `json:"context,omitempty"`
 	// Final computed state of all entities after processing all acts
 	FinalState map[string]interface{} `json:"final_state,omitempty"`
+	// Time budget for the conversation in milliseconds (SLA)
+	TimeBudgetMs *int64 `json:"time_budget_ms,omitempty"`
 	// Additional conversation metadata
 	Metadata *ConversationMetadata `json:"metadata,omitempty"`
+
+	// Hooks run by AddAct, in registration order; never serialized
+	hooks []ActHook
 }
 
 // MarshalJSON implements custom JSON marshaling for Conversation
 func (c Conversation) MarshalJSON() ([]byte, error) {
 	type Alias Conversation
-	
-	// Convert acts to ActUnion for JSON serialization
-	acts := make([]ActUnion, len(c.Acts))
-	for i, act := range c.Acts {
-		acts[i] = NewActUnion(act)
+
+	// Acts are serialized flat: each act carries its own type discriminator,
+	// which is the shape UnmarshalJSON and the conversation schema expect
+	acts := c.Acts
+	if acts == nil {
+		acts = []ConversationAct{}
 	}
-	
+
 	return json.Marshal(&struct {
-		Acts []ActUnion `json:"acts"`
+		Acts []ConversationAct `json:"acts"`
 		*Alias
 	}{
 		Acts:  acts,
@@ -1,7 +1,24 @@
 package astra
 
 import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -45,6 +62,126 @@ func TestGenerateConversationID(t *testing.T) {
 	assert.Regexp(t, `^conv_[a-zA-Z0-9_-]+$`, id2)
 }
 
+func TestSeededIDGenerator(t *testing.T) {
+	clock := func() time.Time { return time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC) }
+
+	gen1 := NewIDGenerator(42, clock)
+	gen2 := NewIDGenerator(42, clock)
+
+	// Same seed and clock should produce the same sequence
+	for i := 0; i < 3; i++ {
+		id := gen1.ActID()
+		assert.Equal(t, id, gen2.ActID())
+		assert.True(t, IsValidActID(id), "Seeded act ID should be valid")
+	}
+	convID := gen1.ConversationID()
+	assert.Equal(t, convID, gen2.ConversationID())
+	assert.True(t, IsValidConversationID(convID), "Seeded conversation ID should be valid")
+
+	// Successive IDs still differ
+	assert.NotEqual(t, gen1.ActID(), gen1.ActID())
+
+	// Different seeds diverge
+	assert.NotEqual(t, NewIDGenerator(1, clock).ActID(), NewIDGenerator(2, clock).ActID())
+
+	// A nil clock makes IDs depend on the seed alone
+	assert.Equal(t, NewIDGenerator(7, nil).EntityID("order"), NewIDGenerator(7, nil).EntityID("order"))
+
+	// Installing a seeded IDSource makes the package-level functions reproducible
+	original := IDSource
+	defer func() { IDSource = original }()
+
+	IDSource = NewIDGenerator(42, clock)
+	first := NewConversation(nil)
+	IDSource = NewIDGenerator(42, clock)
+	second := NewConversation(nil)
+	assert.Equal(t, first.ID, second.ID)
+}
+
+func TestGenerateActIDWithContext(t *testing.T) {
+	id := GenerateScopedActID("tenantA")
+	assert.True(t, IsValidActID(id))
+	assert.Regexp(t, `^act_tenantA_[a-z0-9]+_[0-9a-f]{8}$`, id)
+
+	id = GenerateActIDWithContext("tenant A", "", "sms_inbound", "émoji")
+	assert.True(t, IsValidActID(id))
+	assert.Regexp(t, `^act_tenant-A_sms-inbound_-moji_[a-z0-9]+_[0-9a-f]{8}$`, id)
+
+	// A reused scope still yields unique IDs
+	assert.NotEqual(t, GenerateScopedActID("tenantA"), GenerateScopedActID("tenantA"))
+
+	// Seeded generators stay deterministic
+	clock := func() time.Time { return time.Unix(0, 0) }
+	assert.Equal(t, NewIDGenerator(1, clock).ActIDWithContext("tenantA"), NewIDGenerator(1, clock).ActIDWithContext("tenantA"))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	valid := map[string]time.Time{
+		"2025-01-15T14:30:00Z":        time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC),
+		"2025-01-15T14:30:00.123456Z": time.Date(2025, 1, 15, 14, 30, 0, 123456000, time.UTC),
+		"2025-01-15T09:30:00-05:00":   time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC),
+		"2025-01-15T16:30:00.5+02:00": time.Date(2025, 1, 15, 14, 30, 0, 500000000, time.UTC),
+	}
+	for input, want := range valid {
+		got, err := ParseTimestamp(input)
+		require.NoError(t, err, input)
+		assert.True(t, want.Equal(got), input)
+	}
+
+	invalid := map[string]string{
+		"2025-01-15T14:30:00":      "timestamp has no timezone offset",
+		"2025-01-15T14:30:00.250":  "timestamp has no timezone offset",
+		"2025-01-15":               "timestamp is not in RFC 3339 format",
+		"2025-01-15 14:30:00Z":     "timestamp is not in RFC 3339 format",
+		"20250115T143000Z":         "timestamp is not in RFC 3339 format",
+		"2025-01-15T14:30:00+0500": "timestamp is not in RFC 3339 format",
+		"yesterday":                "timestamp is not in RFC 3339 format",
+	}
+	for input, message := range invalid {
+		_, err := ParseTimestamp(input)
+		var validationErr ValidationError
+		require.ErrorAs(t, err, &validationErr, input)
+		assert.Equal(t, "timestamp", validationErr.Field, input)
+		assert.Equal(t, message, validationErr.Message, input)
+		assert.ErrorIs(t, err, ErrInvalidField, input)
+	}
+
+	// Decoding reports the field instead of a generic JSON error
+	_, err := UnmarshalAct([]byte(`{"id": "act_123", "timestamp": "2025-01-15T14:30:00", "speaker": "agent_123", "type": "fact", "entity": "order_789", "field": "email", "value": "user@example.com"}`))
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "timestamp", validationErr.Field)
+
+	_, err = UnmarshalAct([]byte(`{"id": "act_123", "timestamp": 1736951400, "speaker": "agent_123", "type": "fact", "entity": "order_789", "field": "email", "value": "user@example.com"}`))
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "timestamp must be a string", validationErr.Message)
+
+	_, err = UnmarshalConversation([]byte(`{"id": "conv_1", "participants": [], "acts": [{"id": "act_123", "timestamp": "15/01/2025 14:30", "speaker": "agent_123", "type": "fact", "entity": "order_789", "field": "email", "value": "user@example.com"}]}`), false)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "timestamp", validationErr.Field)
+}
+
+func TestParseActID(t *testing.T) {
+	generatedAt := time.Date(2025, 1, 15, 14, 30, 0, 123000000, time.UTC)
+	generator := NewIDGenerator(1, func() time.Time { return generatedAt })
+
+	parsed, err := ParseActID(generator.ActID())
+	require.NoError(t, err)
+	assert.Equal(t, generatedAt, parsed.Timestamp)
+	assert.Empty(t, parsed.Context)
+	assert.Len(t, parsed.Random, 8)
+
+	parsed, err = ParseActID(generator.ActIDWithContext("tenantA", "voice"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tenantA", "voice"}, parsed.Context)
+	assert.Equal(t, generatedAt, parsed.Timestamp)
+
+	for _, id := range []string{"act_1", "act_123_abc_def", "act_order_deadbeef", "conv_m5k2x1ab_deadbeef", ""} {
+		_, err := ParseActID(id)
+		assert.ErrorIs(t, err, ErrInvalidField, id)
+	}
+}
+
 func TestIsValidActID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -93,6 +230,80 @@ func TestIsValidConversationID(t *testing.T) {
 	}
 }
 
+func TestIsValidEntityID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected bool
+	}{
+		{"Generated entity ID", GenerateEntityID("order"), true},
+		{"Plain ID", "order_789", true},
+		{"UUID", "3f2b8c1e-9d4a-4e7b-8f6a-2c1d0e9b7a54", true},
+		{"External system ID", "gid://shopify/Order/450789469", true},
+		{"Non-ASCII ID", "commande_été", true},
+		{"Longest ID", strings.Repeat("a", 256), true},
+		{"Invalid - too long", strings.Repeat("a", 257), false},
+		{"Invalid - spaces", "order 789", false},
+		{"Invalid - only whitespace", " ", false},
+		{"Invalid - trailing newline", "order_789\n", false},
+		{"Invalid - no-break space", "order\u00a0789", false},
+		{"Invalid - control character", "order\x00789", false},
+		{"Empty string", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidEntityID(tt.id)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	assert.NoError(t, NewEntity("order_789", "order").Validate())
+	var validationErr ValidationError
+	require.ErrorAs(t, NewEntity(" order_789", "order").Validate(), &validationErr)
+	assert.Equal(t, "id", validationErr.Field)
+	assert.ErrorIs(t, Entity{Type: "order"}.Validate(), ErrMissingField)
+}
+
+func TestEntityValidate(t *testing.T) {
+	assert.NoError(t, NewEntity("order_789", "order", WithSchemaURL("https://schemas.example.com/order.json")).Validate())
+
+	var validationErr ValidationError
+	err := Entity{ID: "order_789"}.Validate()
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "type", validationErr.Field)
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	err = NewEntity("order_789", "order", WithSchemaURL("schemas/order.json")).Validate()
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "schema_url", validationErr.Field)
+	assert.Error(t, NewEntity("order_789", "order", WithSchemaURL("https://example.com/%zz")).Validate())
+
+	// Structured entities are checked as part of facts, confirms, and commits
+	untyped := Entity{ID: "order_789"}
+	for _, act := range []ConversationAct{
+		NewFact("customer_456", untyped, "quantity", 2),
+		NewFact("customer_456", &untyped, "quantity", 2),
+		NewFact("customer_456", map[string]interface{}{"id": "order_789"}, "quantity", 2),
+		NewConfirm("agent_123", untyped, "Two pizzas?"),
+		NewCommit("system", untyped, CommitActionCreate),
+	} {
+		err := ValidateAct(act)
+		require.ErrorAs(t, err, &validationErr, "%T", act)
+		assert.Equal(t, "entity.type", validationErr.Field)
+	}
+	var missing *Entity
+	assert.ErrorIs(t, ValidateAct(NewFact("customer_456", missing, "quantity", 2)), ErrMissingField)
+
+	// String references are still accepted as they are
+	assert.NoError(t, ValidateAct(NewFact("customer_456", "order_789", "quantity", 2)))
+	assert.NoError(t, ValidateAct(NewFact("customer_456", NewEntity("order_789", "order"), "quantity", 2)))
+
+	// Decoded JSON objects are validated too
+	_, err = UnmarshalConversation([]byte(`{"id": "conv_1", "participants": [{"id": "customer_456", "type": "human"}], "acts": [{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "fact", "entity": {"id": "order_789"}, "field": "quantity", "value": 2}]}`), true)
+	assert.ErrorContains(t, err, "entity.type")
+}
+
 // ============================================================================
 // Act Creation and Validation Tests
 // ============================================================================
@@ -117,6 +328,26 @@ func TestCreateBaseAct(t *testing.T) {
 	assert.Equal(t, source, *act.Source)
 }
 
+func TestWithMetadataMerges(t *testing.T) {
+	language := "en-US"
+	act := CreateBaseAct("agent_123", ActTypeAsk,
+		WithChannel("voice"),
+		WithMetadata(ActMetadata{
+			Language:             &language,
+			AdditionalProperties: map[string]interface{}{"intent": "order"},
+		}),
+		WithMetadata(ActMetadata{
+			AdditionalProperties: map[string]interface{}{"turn": 3},
+		}))
+
+	require.NotNil(t, act.Metadata)
+	require.NotNil(t, act.Metadata.Channel)
+	assert.Equal(t, "voice", *act.Metadata.Channel)
+	require.NotNil(t, act.Metadata.Language)
+	assert.Equal(t, language, *act.Metadata.Language)
+	assert.Equal(t, map[string]interface{}{"intent": "order", "turn": 3}, act.Metadata.AdditionalProperties)
+}
+
 func TestNewAsk(t *testing.T) {
 	speaker := "agent_123"
 	field := "email"
@@ -211,6 +442,35 @@ func TestNewCommit(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewCommitWithError(t *testing.T) {
+	commit := NewCommit("system_001", "order_456", CommitActionCreate,
+		WithCommitError("CRM_UNAVAILABLE", "CRM is unavailable",
+			WithCommitErrorDetails(map[string]interface{}{"endpoint": "/orders"}),
+			WithCommitErrorRecoverable(true)))
+
+	require.NotNil(t, commit.Error)
+	assert.Equal(t, CommitError{
+		Code:        "CRM_UNAVAILABLE",
+		Message:     "CRM is unavailable",
+		Details:     map[string]interface{}{"endpoint": "/orders"},
+		Recoverable: true,
+	}, *commit.Error)
+	require.NotNil(t, commit.Status)
+	assert.Equal(t, CommitStatusFailed, *commit.Status)
+	assert.NoError(t, ValidateAct(commit))
+
+	// An explicit status wins whichever order the options are given in
+	before := NewCommit("system_001", "order_456", CommitActionCreate,
+		WithCommitStatus(CommitStatusRetrying), WithCommitError("TIMEOUT", "Timed out"))
+	after := NewCommit("system_001", "order_456", CommitActionCreate,
+		WithCommitError("TIMEOUT", "Timed out"), WithCommitStatus(CommitStatusRetrying))
+	for _, c := range []Commit{before, after} {
+		assert.Equal(t, CommitStatusRetrying, *c.Status)
+		assert.False(t, c.Error.Recoverable)
+		assert.NoError(t, ValidateAct(c))
+	}
+}
+
 func TestNewError(t *testing.T) {
 	speaker := "system_001"
 	code := "VALIDATION_ERROR"
@@ -235,6 +495,35 @@ func TestNewError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidatingBuilders(t *testing.T) {
+	ask, err := NewAskE("agent_123", "email", "What is your email address?")
+	require.NoError(t, err)
+	assert.Equal(t, "email", ask.Field)
+
+	_, err = NewAskE("agent_123", "", "What is your email address?")
+	assert.Error(t, err)
+
+	_, err = NewFactE("customer_456", "order_789", "email", "user@example.com")
+	assert.NoError(t, err)
+
+	_, err = NewFactE("customer_456", nil, "email", "user@example.com")
+	assert.Error(t, err)
+
+	_, err = NewConfirmE("agent_123", "order_456", "")
+	assert.Error(t, err)
+
+	_, err = NewCommitE("system_001", "order_456", CommitActionCreate,
+		WithCommitStatus(CommitStatusFailed))
+	assert.Error(t, err)
+
+	_, err = NewErrorE("", "VALIDATION_ERROR", "Invalid email format", true)
+	assert.Error(t, err)
+
+	errorAct, err := NewErrorE("system_001", "VALIDATION_ERROR", "Invalid email format", true)
+	require.NoError(t, err)
+	assert.Equal(t, "VALIDATION_ERROR", errorAct.Code)
+}
+
 // ============================================================================
 // Act Validation Tests
 // ============================================================================
@@ -351,6 +640,330 @@ func TestFactValidation(t *testing.T) {
 	}
 }
 
+func TestConfirmValidation(t *testing.T) {
+	yes, no := true, false
+	timeout := ConfirmationMethodTimeout
+	reason := "Wrong delivery address"
+
+	tests := []struct {
+		name      string
+		confirm   Confirm
+		wantError bool
+	}{
+		{
+			name: "Valid confirm",
+			confirm: Confirm{
+				Act:     CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:  "order_789",
+				Summary: "Order for 2 pizzas",
+			},
+			wantError: false,
+		},
+		{
+			name: "Missing summary",
+			confirm: Confirm{
+				Act:    CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity: "order_789",
+			},
+			wantError: true,
+		},
+		{
+			name: "Awaiting with confirmed set",
+			confirm: Confirm{
+				Act:       CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:    "order_789",
+				Summary:   "Order for 2 pizzas",
+				Awaiting:  &yes,
+				Confirmed: &yes,
+			},
+			wantError: true,
+		},
+		{
+			name: "Accepted confirmation",
+			confirm: Confirm{
+				Act:       CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:    "order_789",
+				Summary:   "Order for 2 pizzas",
+				Awaiting:  &no,
+				Confirmed: &yes,
+			},
+			wantError: false,
+		},
+		{
+			name: "Rejected without reason",
+			confirm: Confirm{
+				Act:       CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:    "order_789",
+				Summary:   "Order for 2 pizzas",
+				Awaiting:  &no,
+				Confirmed: &no,
+			},
+			wantError: true,
+		},
+		{
+			name: "Rejected with reason",
+			confirm: Confirm{
+				Act:             CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:          "order_789",
+				Summary:         "Order for 2 pizzas",
+				Awaiting:        &no,
+				Confirmed:       &no,
+				RejectionReason: &reason,
+			},
+			wantError: false,
+		},
+		{
+			name: "Timeout while awaiting",
+			confirm: Confirm{
+				Act:                CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:             "order_789",
+				Summary:            "Order for 2 pizzas",
+				Awaiting:           &yes,
+				ConfirmationMethod: &timeout,
+			},
+			wantError: true,
+		},
+		{
+			name: "Timeout without awaiting defaults to awaiting",
+			confirm: Confirm{
+				Act:                CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:             "order_789",
+				Summary:            "Order for 2 pizzas",
+				ConfirmationMethod: &timeout,
+			},
+			wantError: true,
+		},
+		{
+			name: "Timeout no longer awaiting",
+			confirm: Confirm{
+				Act:                CreateBaseAct("agent_123", ActTypeConfirm),
+				Entity:             "order_789",
+				Summary:            "Order for 2 pizzas",
+				Awaiting:           &no,
+				ConfirmationMethod: &timeout,
+			},
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.confirm.Validate()
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommitValidation(t *testing.T) {
+	success := CommitStatusSuccess
+	failed := CommitStatusFailed
+	retrying := CommitStatusRetrying
+	commitError := &CommitError{Code: "CRM_UNAVAILABLE", Message: "CRM is unavailable", Recoverable: true}
+
+	tests := []struct {
+		name      string
+		commit    Commit
+		wantError bool
+	}{
+		{
+			name: "Valid commit",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+			},
+			wantError: false,
+		},
+		{
+			name: "Missing action",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+			},
+			wantError: true,
+		},
+		{
+			name: "Successful commit without error",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &success,
+			},
+			wantError: false,
+		},
+		{
+			name: "Successful commit with error",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &success,
+				Error:  commitError,
+			},
+			wantError: true,
+		},
+		{
+			name: "Failed commit with error",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &failed,
+				Error:  commitError,
+			},
+			wantError: false,
+		},
+		{
+			name: "Failed commit without error",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &failed,
+			},
+			wantError: true,
+		},
+		{
+			name: "Retrying commit without error",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &retrying,
+			},
+			wantError: true,
+		},
+		{
+			name: "Failed commit with empty error code",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &failed,
+				Error:  &CommitError{Message: "CRM is unavailable"},
+			},
+			wantError: true,
+		},
+		{
+			name: "Retrying commit with empty error message",
+			commit: Commit{
+				Act:    CreateBaseAct("system_001", ActTypeCommit),
+				Entity: "order_789",
+				Action: CommitActionCreate,
+				Status: &retrying,
+				Error:  &CommitError{Code: "CRM_UNAVAILABLE"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.commit.Validate()
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateActs(t *testing.T) {
+	acts := []ConversationAct{
+		NewAsk("agent_123", "email", "What's your email?"),
+		NewAsk("agent_123", "", "What's your email?"),
+		NewFact("customer_456", "order_789", "email", "user@example.com"),
+		NewConfirm("agent_123", "order_789", "Order confirmed"),
+		NewFact("customer_456", "order_789", "", "user@example.com"),
+	}
+
+	errs := ValidateActs(acts)
+	require.Len(t, errs, 2)
+	assert.Equal(t, 1, errs[0].Index)
+	assert.Equal(t, 4, errs[1].Index)
+	assert.ErrorIs(t, errs[1], ErrMissingField)
+	assert.True(t, strings.HasPrefix(errs[0].Error(), "act 1: "))
+
+	assert.Empty(t, ValidateActs(acts[2:4]))
+	assert.NotNil(t, ValidateActs(nil))
+}
+
+func TestCommitCanRetry(t *testing.T) {
+	failed := CommitStatusFailed
+	success := CommitStatusSuccess
+	newCommit := func(retries, maxRetries int) Commit {
+		commit := NewCommit("system_001", "order_789", CommitActionCreate, WithCommitStatus(failed))
+		commit.Error = &CommitError{Code: "CRM_UNAVAILABLE", Message: "CRM is unavailable", Recoverable: true}
+		commit.RetryCount = &retries
+		commit.MaxRetries = &maxRetries
+		return commit
+	}
+
+	assert.True(t, newCommit(2, 3).CanRetry())
+	assert.False(t, newCommit(3, 3).CanRetry(), "retry budget is exhausted when RetryCount equals MaxRetries")
+	assert.False(t, newCommit(4, 3).CanRetry())
+
+	fresh := newCommit(0, 3)
+	fresh.RetryCount = nil
+	assert.True(t, fresh.CanRetry())
+
+	unbounded := newCommit(0, 3)
+	unbounded.MaxRetries = nil
+	assert.False(t, unbounded.CanRetry())
+
+	fatal := newCommit(0, 3)
+	fatal.Error.Recoverable = false
+	assert.False(t, fatal.CanRetry())
+
+	succeeded := newCommit(0, 3)
+	succeeded.Status = &success
+	assert.False(t, succeeded.CanRetry())
+}
+
+func TestCommitIdempotency(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("system_001", ParticipantTypeSystem)})
+	first := NewCommit("system_001", "order_789", CommitActionCreate, WithIdempotencyKey("order-789-create"))
+	unkeyed := NewCommit("system_001", "order_789", CommitActionUpdate)
+	replayed := NewCommit("system_001", "order_789", CommitActionCreate, WithIdempotencyKey("order-789-create"))
+	unkeyedAgain := NewCommit("system_001", "order_789", CommitActionUpdate)
+	other := NewCommit("system_001", "order_790", CommitActionCreate, WithIdempotencyKey("order-790-create"))
+	for _, act := range []ConversationAct{first, unkeyed, replayed, unkeyedAgain, other} {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	assert.Equal(t, map[string][]string{
+		"order-789-create": {first.ID, replayed.ID},
+	}, conv.DuplicateIdempotencyKeys())
+
+	deduped := conv.DedupeCommits()
+	assert.Equal(t, []ConversationAct{first, unkeyed, unkeyedAgain, other}, deduped.Acts)
+	assert.Equal(t, 4, *deduped.Metadata.ActCount)
+	assert.Len(t, conv.Acts, 5, "the original conversation should be untouched")
+	assert.Empty(t, deduped.DuplicateIdempotencyKeys())
+}
+
+func TestNextRetryBackoff(t *testing.T) {
+	base := 100 * time.Millisecond
+	for attempt := 0; attempt < 5; attempt++ {
+		delay := base << uint(attempt)
+		for i := 0; i < 20; i++ {
+			backoff := NextRetryBackoff(attempt, base)
+			assert.GreaterOrEqual(t, backoff, delay/2)
+			assert.Less(t, backoff, delay)
+		}
+	}
+
+	assert.Equal(t, time.Duration(0), NextRetryBackoff(-1, base))
+	assert.Equal(t, time.Duration(0), NextRetryBackoff(3, 0))
+	assert.Positive(t, NextRetryBackoff(200, time.Hour), "huge delays are capped rather than overflowing")
+}
+
 // ============================================================================
 // Type Guard Tests
 // ============================================================================
@@ -412,7 +1025,7 @@ func TestEntityTypeGuards(t *testing.T) {
 func TestActJSONSerialization(t *testing.T) {
 	ask := NewAsk("agent_123", "email", "What's your email?",
 		WithRequired(true),
-		WithConfidence(0.95))
+		WithAct[Ask](WithConfidence(0.95)))
 
 	// Marshal to JSON
 	jsonData, err := json.Marshal(ask)
@@ -493,6 +1106,18 @@ func TestUnmarshalAct(t *testing.T) {
 			`,
 			shouldError: true,
 		},
+		{
+			name: "Timestamp without timezone",
+			jsonData: `{
+				"id": "act_123",
+				"timestamp": "2025-01-15T14:30:00",
+				"speaker": "agent_123",
+				"type": "ask",
+				"field": "email",
+				"prompt": "What's your email?"
+			}`,
+			shouldError: true,
+		},
 		{
 			name: "Unknown act type",
 			jsonData: `{
@@ -625,6 +1250,51 @@ func TestGetEntityID(t *testing.T) {
 	}
 }
 
+func TestNormalizeEntityRef(t *testing.T) {
+	order := Entity{ID: "order_456", Type: "order"}
+
+	tests := []struct {
+		name        string
+		entityRef   EntityRef
+		expected    Entity
+		shouldError bool
+	}{
+		{"String entity ref", "order_123", Entity{ID: "order_123"}, false},
+		{"Entity struct ref", order, order, false},
+		{"Entity pointer ref", &order, order, false},
+		{"Decoded JSON object", map[string]interface{}{"id": "order_456", "type": "order"}, order, false},
+		{"Nil entity pointer", (*Entity)(nil), Entity{}, true},
+		{"Invalid type", 123, Entity{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entity, err := NormalizeEntityRef(tt.entityRef)
+			if tt.shouldError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, entity)
+			}
+		})
+	}
+
+	fact := NewFact("customer_456", &order, "status", "shipped")
+	entity, err := fact.EntityRef()
+	require.NoError(t, err)
+	assert.Equal(t, order, entity)
+
+	confirm := NewConfirm("agent_123", "order_456", "Ship order?")
+	entity, err = confirm.EntityRef()
+	require.NoError(t, err)
+	assert.Equal(t, "order_456", entity.ID)
+
+	commit := NewCommit("system_001", order, CommitActionUpdate)
+	entity, err = commit.EntityRef()
+	require.NoError(t, err)
+	assert.Equal(t, "order", entity.Type)
+}
+
 // ============================================================================
 // Conversation Tests
 // ============================================================================
@@ -668,17 +1338,56 @@ func TestConversationAddAct(t *testing.T) {
 	assert.Equal(t, 1, *conv.Metadata.ActCount)
 }
 
-func TestConversationGetMethods(t *testing.T) {
+func TestConversationActHooks(t *testing.T) {
 	participants := []Participant{
 		NewParticipant("agent_123", ParticipantTypeAI),
 		NewParticipant("customer_456", ParticipantTypeHuman),
 	}
 	conv := NewConversation(participants)
 
-	// Add various acts
-	ask := NewAsk("agent_123", "email", "What's your email?")
+	var calls []string
+	conv.Use(func(prev []ConversationAct, act ConversationAct) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	conv.Use(func(prev []ConversationAct, act ConversationAct) error {
+		calls = append(calls, "second")
+		if act.GetType() == ActTypeFact && len(prev) == 0 {
+			return errors.New("facts must follow an ask")
+		}
+		return nil
+	})
+
+	// Hooks run in registration order and a failing hook rejects the act
 	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
-	errorAct := NewError("system_001", "ERROR", "Something went wrong", true)
+	err := conv.AddAct(fact)
+	assert.ErrorContains(t, err, "act hook 1 failed")
+	assert.Equal(t, []string{"first", "second"}, calls)
+	assert.Empty(t, conv.Acts)
+
+	// Hooks see the acts already in the conversation
+	require.NoError(t, conv.AddAct(NewAsk("agent_123", "email", "What's your email?")))
+	require.NoError(t, conv.AddAct(fact))
+	assert.Len(t, conv.Acts, 2)
+
+	// Invalid acts are rejected before any hook runs
+	calls = nil
+	err = conv.AddAct(NewAsk("", "email", "What's your email?"))
+	assert.Error(t, err)
+	assert.Empty(t, calls)
+}
+
+func TestConversationGetMethods(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}
+	conv := NewConversation(participants)
+
+	// Add various acts
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
+	errorAct := NewError("system_001", "ERROR", "Something went wrong", true)
 
 	conv.AddAct(ask)
 	conv.AddAct(fact)
@@ -710,6 +1419,169 @@ func TestConversationGetMethods(t *testing.T) {
 	assert.Nil(t, nonExistent)
 }
 
+func TestConversationTurns(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	assert.Empty(t, conv.Turns())
+	_, ok := conv.LastActBySpeaker("agent_123")
+	assert.False(t, ok)
+
+	greeting := NewAsk("agent_123", "name", "Hi! What's your name?")
+	name := NewFact("customer_456", "customer_456", "name", "Jane")
+	askEmail := NewAsk("agent_123", "email", "Thanks Jane. What's your email?")
+	email := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	phone := NewFact("customer_456", "customer_456", "phone", "+15551234567")
+	confirm := NewConfirm("agent_123", "customer_456", "Email and phone noted")
+	conv.Acts = []ConversationAct{greeting, name, askEmail, email, phone, confirm}
+
+	turns := conv.Turns()
+	require.Len(t, turns, 5)
+	assert.Equal(t, Turn{Speaker: "agent_123", Acts: []ConversationAct{greeting}}, turns[0])
+	assert.Equal(t, Turn{Speaker: "customer_456", Acts: []ConversationAct{name}}, turns[1])
+	assert.Equal(t, Turn{Speaker: "agent_123", Acts: []ConversationAct{askEmail}}, turns[2])
+	assert.Equal(t, Turn{Speaker: "customer_456", Acts: []ConversationAct{email, phone}}, turns[3])
+	assert.Equal(t, Turn{Speaker: "agent_123", Acts: []ConversationAct{confirm}}, turns[4])
+
+	// Appending to a turn leaves the conversation alone
+	extended := append(turns[3].Acts, NewAsk("agent_123", "address", "What's your address?"))
+	assert.Len(t, extended, 3)
+	assert.Equal(t, confirm.ID, conv.Acts[5].GetAct().ID)
+
+	last, ok := conv.LastActBySpeaker("customer_456")
+	require.True(t, ok)
+	assert.Equal(t, phone.ID, last.GetAct().ID)
+	last, ok = conv.LastActBySpeaker("agent_123")
+	require.True(t, ok)
+	assert.Equal(t, confirm.ID, last.GetAct().ID)
+	_, ok = conv.LastActBySpeaker("supervisor_789")
+	assert.False(t, ok)
+}
+
+func TestConversationGetActsByEntity(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	order := NewEntity("order_789", "order")
+
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	byString := NewFact("customer_456", "order_789", "email", "user@example.com")
+	byStruct := NewFact("customer_456", order, "quantity", 2)
+	byPointer := NewConfirm("agent_123", &order, "Place the order?")
+	other := NewFact("customer_456", "order_790", "email", "user@example.com")
+	commit := NewCommit("system_001", order, CommitActionCreate)
+	errorAct := NewError("system_001", "TIMEOUT", "Order service timed out", true, WithRelatedActID(commit.ID))
+	conv.Acts = []ConversationAct{ask, byString, byStruct, byPointer, other, commit, errorAct}
+
+	assert.Equal(t, []ConversationAct{byString, byStruct, byPointer, commit}, conv.GetActsByEntity("order_789"))
+	assert.Equal(t, []ConversationAct{other}, conv.GetActsByEntity("order_790"))
+	assert.Empty(t, conv.GetActsByEntity("order_000"))
+}
+
+func TestConversationActPaging(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ask := NewAsk("agent_123", fmt.Sprintf("field_%d", i), "Prompt")
+		conv.AddAct(ask)
+		ids = append(ids, ask.ID)
+	}
+	actIDs := func(acts []ConversationAct) []string {
+		result := []string{}
+		for _, act := range acts {
+			result = append(result, act.GetAct().ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, ids[1:3], actIDs(conv.ActPage(1, 2)))
+	assert.Equal(t, ids[3:], actIDs(conv.ActPage(3, 10)))
+	assert.Equal(t, ids[:2], actIDs(conv.ActPage(-4, 2)))
+	assert.Empty(t, conv.ActPage(5, 2))
+	assert.Empty(t, conv.ActPage(100, 2))
+	assert.Empty(t, conv.ActPage(0, 0))
+	assert.Equal(t, ids, actIDs(conv.ActPage(0, math.MaxInt)))
+
+	page := conv.ActPage(0, 2)
+	page[0] = NewAsk("agent_123", "other", "Prompt")
+	assert.Equal(t, ids[0], conv.Acts[0].GetAct().ID, "pages are copies")
+
+	around, err := conv.ActsAround(ids[2], 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ids[1:4], actIDs(around))
+
+	around, err = conv.ActsAround(ids[0], 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ids[:2], actIDs(around))
+
+	around, err = conv.ActsAround(ids[4], -1, math.MaxInt)
+	require.NoError(t, err)
+	assert.Equal(t, ids[4:], actIDs(around))
+
+	_, err = conv.ActsAround("act_missing", 1, 1)
+	assert.ErrorIs(t, err, ErrActNotFound)
+}
+
+func TestConversationRelatedActs(t *testing.T) {
+	conv := newWorkflowConversation(t)
+	fact := conv.Acts[1]
+	factID := fact.GetAct().ID
+
+	found, err := conv.GetActByID(factID)
+	require.NoError(t, err)
+	assert.Equal(t, fact, found)
+
+	_, err = conv.GetActByID("act_missing")
+	var notFound ActNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "act_missing", notFound.ActID)
+
+	validationError := NewError("system_001", "INVALID_EMAIL", "Email domain does not exist", true,
+		WithRelatedActID(factID))
+	orphanError := NewError("system_001", "TIMEOUT", "Lookup timed out", true,
+		WithRelatedActID("act_dropped"))
+	require.NoError(t, conv.AddAct(validationError))
+	require.NoError(t, conv.AddAct(orphanError))
+	require.NoError(t, conv.AddAct(NewError("system_001", "TIMEOUT", "Lookup timed out again", true,
+		WithRelatedActID("act_dropped"))))
+
+	assert.Equal(t, []ConversationAct{validationError}, conv.RelatedActs(factID))
+	assert.Equal(t, []Error{validationError}, conv.ErrorsForAct(factID))
+	assert.Empty(t, conv.ErrorsForAct(conv.Acts[0].GetAct().ID))
+	assert.Equal(t, []string{"act_dropped"}, conv.DanglingReferences())
+}
+
+func TestConversationGetParticipants(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI, WithRole("agent")),
+		NewParticipant("agent_789", ParticipantTypeHuman, WithRole("agent")),
+		NewParticipant("customer_456", ParticipantTypeHuman, WithRole("customer")),
+		NewParticipant("system_001", ParticipantTypeSystem),
+	}
+	conv := NewConversation(participants)
+
+	// Test GetParticipantsByRole
+	agents := conv.GetParticipantsByRole("agent")
+	require.Len(t, agents, 2)
+	assert.Equal(t, "agent_123", agents[0].ID)
+	assert.Equal(t, "agent_789", agents[1].ID)
+
+	assert.Len(t, conv.GetParticipantsByRole("customer"), 1)
+	assert.Empty(t, conv.GetParticipantsByRole(""))
+	assert.Empty(t, conv.GetParticipantsByRole("manager"))
+
+	// Test GetParticipantsByType
+	humans := conv.GetParticipantsByType(ParticipantTypeHuman)
+	require.Len(t, humans, 2)
+	assert.Equal(t, "agent_789", humans[0].ID)
+	assert.Equal(t, "customer_456", humans[1].ID)
+
+	assert.Len(t, conv.GetParticipantsByType(ParticipantTypeSystem), 1)
+	assert.Empty(t, conv.GetParticipantsByType(ParticipantTypeBot))
+}
+
 func TestConversationEndConversation(t *testing.T) {
 	participants := []Participant{
 		NewParticipant("agent_123", ParticipantTypeAI),
@@ -727,6 +1599,771 @@ func TestConversationEndConversation(t *testing.T) {
 	assert.NotNil(t, conv.Metadata)
 	assert.NotNil(t, conv.Metadata.TotalDurationMs)
 	assert.Greater(t, *conv.Metadata.TotalDurationMs, int64(0))
+
+	// Terminal statuses cannot be left
+	assert.Error(t, conv.EndConversation(ConversationStatusFailed))
+	assert.Equal(t, ConversationStatusCompleted, *conv.Status)
+
+	// Ending again with the same status does not move the end time
+	endedAt := *conv.EndedAt
+	assert.ErrorContains(t, conv.EndConversationAt(ConversationStatusCompleted, endedAt.Add(time.Hour)), "already ended")
+	assert.Equal(t, endedAt, *conv.EndedAt)
+
+	// Only terminal statuses end a conversation
+	active := NewConversation(participants)
+	for _, status := range []ConversationStatus{ConversationStatusActive, ConversationStatusPaused} {
+		assert.ErrorContains(t, active.EndConversation(status), "non-terminal")
+		assert.Nil(t, active.EndedAt)
+		assert.Equal(t, ConversationStatusActive, *active.Status)
+	}
+	require.NoError(t, active.EndConversation(ConversationStatusCancelled))
+	assert.NotNil(t, active.EndedAt)
+}
+
+func TestConversationSetStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      ConversationStatus
+		to        ConversationStatus
+		wantError bool
+	}{
+		{"Active to paused", ConversationStatusActive, ConversationStatusPaused, false},
+		{"Active to completed", ConversationStatusActive, ConversationStatusCompleted, false},
+		{"Active to failed", ConversationStatusActive, ConversationStatusFailed, false},
+		{"Paused to active", ConversationStatusPaused, ConversationStatusActive, false},
+		{"Paused to cancelled", ConversationStatusPaused, ConversationStatusCancelled, false},
+		{"Paused to completed", ConversationStatusPaused, ConversationStatusCompleted, true},
+		{"Completed to active", ConversationStatusCompleted, ConversationStatusActive, true},
+		{"Failed to active", ConversationStatusFailed, ConversationStatusActive, true},
+		{"Cancelled to paused", ConversationStatusCancelled, ConversationStatusPaused, true},
+		{"Same status", ConversationStatusCompleted, ConversationStatusCompleted, false},
+		{"Unknown status", ConversationStatusActive, ConversationStatus("archived"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := NewConversation(nil, WithConversationStatus(tt.from))
+			err := conv.SetStatus(tt.to)
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.from, *conv.Status)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, *conv.Status)
+			}
+		})
+	}
+}
+
+func TestConversationFactsForEntity(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}
+	conv := NewConversation(participants)
+	order := NewEntity("order_789", "order")
+
+	acts := []ConversationAct{
+		NewFact("customer_456", "order_789", "email", "old@example.com"),
+		NewFact("customer_456", order, "email", "user@example.com",
+			WithOperation(FieldOperationSet)),
+		NewFact("customer_456", "order_000", "email", "other@example.com"),
+		NewFact("customer_456", &order, "quantity", 2),
+		NewFact("customer_456", "order_789", "quantity", 3,
+			WithOperation(FieldOperationIncrement)),
+		NewFact("customer_456", "order_789", "notes", "leave at door"),
+		NewFact("customer_456", "order_789", "notes", "",
+			WithOperation(FieldOperationDelete)),
+	}
+	for _, act := range acts {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	// String and structured references resolve to the same entity
+	facts := conv.FactsForEntity("order_789")
+	assert.Len(t, facts, 6)
+	assert.Len(t, conv.FactsForEntity("order_000"), 1)
+	assert.Empty(t, conv.FactsForEntity("order_missing"))
+
+	value, ok := conv.LatestFieldValue("order_789", "email")
+	assert.True(t, ok)
+	assert.Equal(t, "user@example.com", value)
+
+	value, ok = conv.LatestFieldValue("order_789", "quantity")
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, value)
+
+	// Deleted fields are reported as not found
+	value, ok = conv.LatestFieldValue("order_789", "notes")
+	assert.False(t, ok)
+	assert.Nil(t, value)
+
+	_, ok = conv.LatestFieldValue("order_789", "missing")
+	assert.False(t, ok)
+
+	assert.Equal(t, map[string]interface{}{
+		"order_789": map[string]interface{}{"email": "user@example.com", "quantity": 5.0},
+		"order_000": map[string]interface{}{"email": "other@example.com"},
+	}, conv.ComputeFinalState())
+}
+
+func TestConversationStateAt(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("customer_456", ParticipantTypeHuman)})
+	first := NewFact("customer_456", "order_789", "email", "wrong@example.com")
+	ask := NewAsk("customer_456", "quantity", "How many?")
+	second := NewFact("customer_456", "order_789", "quantity", 2)
+	third := NewFact("customer_456", "order_789", "email", "user@example.com")
+	for _, act := range []ConversationAct{first, ask, second, third} {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	state, err := conv.StateAt(first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"order_789": map[string]interface{}{"email": "wrong@example.com"},
+	}, state)
+
+	// Non-fact acts leave the state as it was
+	state, err = conv.StateAt(ask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"order_789": map[string]interface{}{"email": "wrong@example.com"},
+	}, state)
+
+	state, err = conv.StateAt(second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"order_789": map[string]interface{}{"email": "wrong@example.com", "quantity": 2},
+	}, state)
+
+	state, err = conv.StateAt(third.ID)
+	require.NoError(t, err)
+	assert.Equal(t, conv.ComputeFinalState(), state)
+
+	_, err = conv.StateAt("act_missing")
+	var notFound ActNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "act_missing", notFound.ActID)
+}
+
+func TestTypedFacts(t *testing.T) {
+	type address struct {
+		City  string   `json:"city"`
+		Lines []string `json:"lines"`
+	}
+
+	quantity := NewTypedFact("customer_456", "order_789", "quantity", 2)
+	assert.Equal(t, 2, quantity.Value)
+	n, err := TypedValue[int](quantity)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// Typed facts share NewFact's wire format and survive a JSON round trip
+	home := NewTypedFact("customer_456", "customer_456", "address",
+		address{City: "Austin", Lines: []string{"12 Main St"}}, WithOperation(FieldOperationSet))
+	untyped := NewFact("customer_456", "customer_456", "address",
+		map[string]interface{}{"city": "Austin", "lines": []interface{}{"12 Main St"}}, WithOperation(FieldOperationSet))
+	untyped.Act = home.Act
+	data, err := MarshalAct(home)
+	require.NoError(t, err)
+	expected, err := MarshalAct(untyped)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(data))
+
+	decoded, err := UnmarshalAct(data)
+	require.NoError(t, err)
+	decodedFact := decoded.(Fact)
+	assert.IsType(t, map[string]interface{}{}, decodedFact.Value)
+	got, err := TypedValue[address](decodedFact)
+	require.NoError(t, err)
+	assert.Equal(t, address{City: "Austin", Lines: []string{"12 Main St"}}, got)
+
+	// float64 from JSON bridges to integer types
+	n, err = TypedValue[int](NewFact("customer_456", "order_789", "quantity", 3.0))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Values that do not fit T are errors
+	_, err = TypedValue[int](NewFact("customer_456", "order_789", "quantity", 2.5))
+	assert.Error(t, err)
+	_, err = TypedValue[int](NewFact("customer_456", "order_789", "quantity", "two"))
+	assert.ErrorContains(t, err, "fact quantity value of type string cannot be converted to int")
+	_, err = TypedValue[string](NewFact("customer_456", "order_789", "quantity", nil))
+	assert.ErrorContains(t, err, "has no value")
+}
+
+func TestApplyFact(t *testing.T) {
+	fact := func(field string, value interface{}, operation FieldOperation) Fact {
+		return NewFact("customer_456", "order_789", field, value, WithOperation(operation))
+	}
+
+	state := map[string]interface{}{
+		"quantity": 2.0,
+		"email":    "user@example.com",
+		"tags":     []interface{}{"gift"},
+		"labels":   []string{"fragile"},
+		"note":     "leave at ",
+		"address":  map[string]interface{}{"city": "Austin"},
+	}
+
+	require.NoError(t, ApplyFact(state, fact("quantity", 3, FieldOperationIncrement)))
+	require.NoError(t, ApplyFact(state, fact("quantity", 1.5, FieldOperationDecrement)))
+	assert.Equal(t, 3.5, state["quantity"])
+
+	// Missing numeric fields start at zero
+	require.NoError(t, ApplyFact(state, fact("discount", 5, FieldOperationDecrement)))
+	assert.Equal(t, -5.0, state["discount"])
+
+	require.NoError(t, ApplyFact(state, fact("tags", "priority", FieldOperationAppend)))
+	require.NoError(t, ApplyFact(state, fact("labels", "urgent", FieldOperationAppend)))
+	require.NoError(t, ApplyFact(state, fact("note", "door", FieldOperationAppend)))
+	require.NoError(t, ApplyFact(state, fact("items", "sku_1", FieldOperationAppend)))
+	assert.Equal(t, []interface{}{"gift", "priority"}, state["tags"])
+	assert.Equal(t, []string{"fragile", "urgent"}, state["labels"])
+	assert.Equal(t, "leave at door", state["note"])
+	assert.Equal(t, []interface{}{"sku_1"}, state["items"])
+
+	require.NoError(t, ApplyFact(state, fact("address", map[string]interface{}{"zip": "78701"}, FieldOperationMerge)))
+	assert.Equal(t, map[string]interface{}{"city": "Austin", "zip": "78701"}, state["address"])
+
+	require.NoError(t, ApplyFact(state, fact("email", nil, FieldOperationDelete)))
+	require.NoError(t, ApplyFact(state, fact("missing", nil, FieldOperationDelete)))
+	assert.NotContains(t, state, "email")
+
+	require.NoError(t, ApplyFact(state, NewFact("customer_456", "order_789", "email", "new@example.com")))
+	assert.Equal(t, "new@example.com", state["email"])
+
+	// Invalid operands and targets are rejected without modifying the state
+	err := ApplyFact(state, fact("quantity", "3", FieldOperationIncrement))
+	assert.ErrorContains(t, err, "field quantity")
+	assert.ErrorContains(t, err, "string")
+	err = ApplyFact(state, fact("email", 1, FieldOperationIncrement))
+	assert.ErrorContains(t, err, "current value of type string is not numeric")
+	err = ApplyFact(state, fact("quantity", "x", FieldOperationAppend))
+	assert.ErrorContains(t, err, "float64 is not a slice or string")
+	err = ApplyFact(state, fact("labels", 7, FieldOperationAppend))
+	assert.ErrorContains(t, err, "int cannot be appended to []string")
+	err = ApplyFact(state, fact("note", 7, FieldOperationAppend))
+	assert.Error(t, err)
+	err = ApplyFact(state, fact("email", map[string]interface{}{}, FieldOperationMerge))
+	assert.ErrorContains(t, err, "field email")
+	assert.Error(t, ApplyFact(state, fact("email", "x", FieldOperation("multiply"))))
+	assert.Equal(t, 3.5, state["quantity"])
+	assert.Equal(t, "new@example.com", state["email"])
+
+	assert.Error(t, ApplyFact(nil, fact("quantity", 1, FieldOperationIncrement)))
+}
+
+func TestFoldMatchesApplyFact(t *testing.T) {
+	facts := []Fact{
+		NewFact("customer_456", "order_789", "note", "leave at "),
+		NewFact("customer_456", "order_789", "note", "door", WithOperation(FieldOperationAppend)),
+		NewFact("customer_456", "order_789", "quantity", 2),
+		NewFact("customer_456", "order_789", "quantity", 3, WithOperation(FieldOperationIncrement)),
+		NewFact("customer_456", "order_789", "quantity", "many", WithOperation(FieldOperationIncrement)),
+		NewFact("customer_456", "order_789", "note", 7, WithOperation(FieldOperationAppend)),
+	}
+
+	conv := NewConversation([]Participant{NewParticipant("customer_456", ParticipantTypeHuman)})
+	applied := make(map[string]interface{})
+	for _, fact := range facts {
+		require.NoError(t, conv.AddAct(fact))
+		_ = ApplyFact(applied, fact)
+	}
+
+	// Appending to a string concatenates, and rejected facts are skipped
+	assert.Equal(t, map[string]interface{}{"note": "leave at door", "quantity": 5.0}, applied)
+	assert.Equal(t, map[string]interface{}{"order_789": applied}, conv.ComputeFinalState())
+
+	state, err := conv.StateAt(facts[len(facts)-1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"order_789": applied}, state)
+
+	for field, want := range applied {
+		value, ok := conv.LatestFieldValue("order_789", field)
+		assert.True(t, ok)
+		assert.Equal(t, want, value)
+	}
+}
+
+func TestConversationQuery(t *testing.T) {
+	base := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	at := func(seconds int) ActOption {
+		return func(a *Act) {
+			a.Timestamp = base.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	ask.Act = CreateBaseAct("agent_123", ActTypeAsk, at(0), WithConfidence(0.9))
+	email := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	email.Act = CreateBaseAct("customer_456", ActTypeFact, at(10), WithConfidence(0.4))
+	order := NewFact("customer_456", NewEntity("order_789", "order"), "quantity", 2)
+	order.Act = CreateBaseAct("customer_456", ActTypeFact, at(20))
+	confirm := NewConfirm("agent_123", "order_789", "Two pizzas?")
+	confirm.Act = CreateBaseAct("agent_123", ActTypeConfirm, at(30), WithConfidence(0.8))
+	for _, act := range []ConversationAct{ask, email, order, confirm} {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	ids := func(acts []ConversationAct) []string {
+		var result []string
+		for _, act := range acts {
+			result = append(result, act.GetAct().ID)
+		}
+		return result
+	}
+	after := base.Add(5 * time.Second)
+	before := base.Add(30 * time.Second)
+	minConfidence := 0.5
+	orderID := "order_789"
+
+	assert.Equal(t, ids(conv.Acts), ids(conv.Query(ActFilter{})))
+	assert.Equal(t, []string{email.ID, order.ID}, ids(conv.Query(ActFilter{Speakers: []string{"customer_456"}})))
+	assert.Equal(t, []string{ask.ID, confirm.ID}, ids(conv.Query(ActFilter{Types: []ActType{ActTypeAsk, ActTypeConfirm}})))
+	assert.Equal(t, []string{email.ID, order.ID}, ids(conv.Query(ActFilter{After: &after, Before: &before})))
+	assert.Equal(t, []string{ask.ID, order.ID, confirm.ID}, ids(conv.Query(ActFilter{MinConfidence: &minConfidence})))
+	assert.Equal(t, []string{order.ID, confirm.ID}, ids(conv.Query(ActFilter{EntityID: &orderID})))
+
+	// All criteria combine
+	assert.Equal(t, []string{order.ID}, ids(conv.Query(ActFilter{
+		Types:         []ActType{ActTypeFact},
+		Speakers:      []string{"customer_456"},
+		After:         &after,
+		MinConfidence: &minConfidence,
+		EntityID:      &orderID,
+	})))
+	assert.Empty(t, conv.Query(ActFilter{Speakers: []string{"nobody"}}))
+}
+
+func TestAskRetries(t *testing.T) {
+	ask := NewAsk("agent_123", "email", "What's your email?", WithMaxRetries(2))
+	assert.False(t, ask.ShouldEscalate())
+
+	retried := ask.IncrementRetry()
+	assert.Nil(t, ask.RetryCount, "the original ask should be untouched")
+	require.NotNil(t, retried.RetryCount)
+	assert.Equal(t, 1, *retried.RetryCount)
+	assert.False(t, retried.ShouldEscalate())
+
+	exhausted := retried.IncrementRetry()
+	assert.Equal(t, 1, *retried.RetryCount)
+	assert.Equal(t, 2, *exhausted.RetryCount)
+	assert.True(t, exhausted.ShouldEscalate(), "escalate once RetryCount reaches MaxRetries")
+
+	unbounded := NewAsk("agent_123", "email", "What's your email?").IncrementRetry()
+	assert.False(t, unbounded.ShouldEscalate())
+}
+
+func TestConversationUnansweredAsks(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	askEmail := NewAsk("agent_123", "email", "What's your email?")
+	askPhone := NewAsk("agent_123", "phone", "What's your phone number?")
+	email := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	askAddress := NewAsk("agent_123", "address", "What's your address?")
+	clearAddress := NewFact("customer_456", "customer_456", "address", nil, WithOperation(FieldOperationDelete))
+	reaskEmail := NewAsk("agent_123", "email", "Could you repeat your email?")
+	conv.Acts = []ConversationAct{askEmail, askPhone, email, askAddress, clearAddress, reaskEmail}
+
+	// The first email ask was answered; the re-ask after the answer was not
+	assert.Equal(t, []Ask{askPhone, askAddress, reaskEmail}, conv.UnansweredAsks())
+
+	conv.Acts = append(conv.Acts, NewFact("customer_456", "order_789", "phone", "+15551234567"))
+	assert.Equal(t, []Ask{askAddress, reaskEmail}, conv.UnansweredAsks())
+
+	// Email was answered on customer_456, so an email on another entity does
+	// not answer the re-ask
+	conv.Acts = append(conv.Acts, NewFact("customer_456", "order_789", "email", "orders@example.com"))
+	assert.Equal(t, []Ask{askAddress, reaskEmail}, conv.UnansweredAsks())
+	conv.Acts = append(conv.Acts, NewFact("customer_456", "customer_456", "email", "jane.doe@example.com"))
+	assert.Equal(t, []Ask{askAddress}, conv.UnansweredAsks())
+}
+
+func TestConversationOutstandingFields(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	conv.AddAct(NewAsk("agent_123", "name", "What's your name?", WithRequired(true)))
+	conv.AddAct(NewAsk("agent_123", "email", "What's your email?"))
+	conv.AddAct(NewAsk("agent_123", "referral", "How did you hear about us?", WithRequired(false)))
+	conv.AddAct(NewFact("customer_456", "customer_456", "name", "Jane"))
+	conv.AddAct(NewAsk("agent_123", "email", "Could you repeat your email?"))
+
+	// Email was asked twice and never answered; referral is optional
+	assert.Equal(t, []string{"email"}, conv.OutstandingFields())
+
+	conv.AddAct(NewFact("customer_456", "customer_456", "email", "jane@example.com"))
+	assert.Empty(t, conv.OutstandingFields())
+
+	// Asking again for a field that was already set does not reopen it
+	conv.AddAct(NewAsk("agent_123", "email", "Is jane@example.com still right?"))
+	assert.Empty(t, conv.OutstandingFields())
+	conv.AddAct(NewAsk("agent_123", "phone", "What's your phone number?"))
+	assert.Equal(t, []string{"phone"}, conv.OutstandingFields())
+}
+
+func TestConversationMatchAsksToFacts(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	earlyPhone := NewFact("customer_456", "customer_456", "phone", "+15551234567")
+	askName := NewAsk("agent_123", "name", "What's your name?")
+	askEmail := NewAsk("agent_123", "email", "What's your email?")
+	askPhone := NewAsk("agent_123", "phone", "What's your phone number?")
+	name := NewFact("customer_456", "customer_456", "name", "Jane")
+	reaskEmail := NewAsk("agent_123", "email", "Could you repeat your email?")
+	email := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	correctedEmail := NewFact("customer_456", "customer_456", "email", "jane.doe@example.com")
+	conv.Acts = []ConversationAct{earlyPhone, askName, askEmail, askPhone, name, reaskEmail, email, correctedEmail}
+
+	matches, unmatched := conv.MatchAsksToFacts()
+
+	// Both email asks are answered by the first email fact after them, and the
+	// phone fact before its ask does not answer it
+	assert.Equal(t, map[string]string{
+		askName.ID:    name.ID,
+		askEmail.ID:   email.ID,
+		reaskEmail.ID: email.ID,
+	}, matches)
+	assert.Equal(t, []string{askPhone.ID}, unmatched)
+
+	// A deletion does not answer an ask
+	conv.Acts = append(conv.Acts, NewFact("customer_456", "customer_456", "phone", nil, WithOperation(FieldOperationDelete)))
+	_, unmatched = conv.MatchAsksToFacts()
+	assert.Equal(t, []string{askPhone.ID}, unmatched)
+
+	// Names were answered on customer_456, so a name on another entity does
+	// not answer a later name ask
+	reaskName := NewAsk("agent_123", "name", "Could you spell your name?")
+	otherName := NewFact("customer_456", "order_789", "name", "Gift for Sam")
+	conv.Acts = append(conv.Acts, reaskName, otherName)
+	matches, unmatched = conv.MatchAsksToFacts()
+	assert.NotContains(t, matches, reaskName.ID)
+	assert.Equal(t, []string{askPhone.ID, reaskName.ID}, unmatched)
+}
+
+func TestConversationExtractionGaps(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+		NewParticipant("supervisor_789", ParticipantTypeHuman),
+	})
+	order := NewEntity("order_789", "order")
+	acts := []ConversationAct{
+		NewAsk("agent_123", "email", "What's your email?"),
+		NewFact("customer_456", "customer_456", "email", "jane@example.com"),
+		NewFact("customer_456", &order, "quantity", 2),
+		NewConfirm("agent_123", order, "Two pizzas?"),
+		NewCommit("agent_123", "payment_001", CommitActionCreate),
+	}
+	for _, act := range acts {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	silent := conv.SilentParticipants()
+	require.Len(t, silent, 1)
+	assert.Equal(t, "supervisor_789", silent[0].ID)
+
+	// The order is referenced three ways but listed once; the payment only in a commit
+	assert.Equal(t, []string{"customer_456", "order_789", "payment_001"}, conv.EntitiesReferenced())
+
+	empty := NewConversation(nil)
+	assert.Empty(t, empty.SilentParticipants())
+	assert.Empty(t, empty.EntitiesReferenced())
+}
+
+func TestConversationNewCorrectiveFact(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	require.NoError(t, conv.AddAct(NewFact("customer_456", NewEntity("customer_456", "customer"), "email", "jane@exmaple.com")))
+	require.NoError(t, conv.AddAct(NewFact("customer_456", "customer_456", "tags", []interface{}{"vip"})))
+
+	correction := conv.NewCorrectiveFact("agent_123", "customer_456", "email", "jane@example.com")
+	assert.Equal(t, "customer_456", correction.Entity)
+	assert.Equal(t, "jane@example.com", correction.Value)
+	assert.Equal(t, "jane@exmaple.com", correction.PreviousValue)
+	require.NotNil(t, correction.Operation)
+	assert.Equal(t, FieldOperationSet, *correction.Operation)
+	assert.Len(t, conv.Acts, 2, "the correction is not added automatically")
+
+	require.NoError(t, conv.AddAct(correction))
+	value, _ := conv.LatestFieldValue("customer_456", "email")
+	assert.Equal(t, "jane@example.com", value)
+
+	// The previous value is a copy, not shared with the original fact
+	retag := conv.NewCorrectiveFact("agent_123", "customer_456", "tags", []interface{}{"regular"})
+	retag.PreviousValue.([]interface{})[0] = "changed"
+	assert.Equal(t, []interface{}{"vip"}, conv.Acts[1].(Fact).Value)
+
+	// A field without a value gets no previous value but is still a set
+	fresh := conv.NewCorrectiveFact("agent_123", "customer_456", "phone", "+15551234567")
+	assert.Nil(t, fresh.PreviousValue)
+	assert.Equal(t, FieldOperationSet, *fresh.Operation)
+}
+
+func TestCheckEntityVersions(t *testing.T) {
+	order := NewEntity("order_789", "order")
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+
+	first := NewVersionedFact("agent_123", order, "9", "status", "confirmed")
+	assert.Nil(t, order.Version, "the entity passed in should not be modified")
+	second := NewVersionedFact("agent_123", order, "10", "status", "shipped")
+	sameRevision := NewVersionedFact("agent_123", order, "10", "carrier", "UPS")
+	stale := NewVersionedFact("agent_123", order, "9", "status", "cancelled")
+	unversioned := NewFact("agent_123", "order_789", "status", "lost")
+	conv.Acts = []ConversationAct{first, second, sameRevision, stale, unversioned}
+
+	entity, err := NormalizeEntityRef(first.Entity)
+	require.NoError(t, err)
+	require.NotNil(t, entity.Version)
+	assert.Equal(t, "9", *entity.Version)
+
+	// "10" is newer than "9" numerically, though not lexically
+	conflicts := conv.CheckEntityVersions()
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, VersionConflict{
+		EntityID:      "order_789",
+		ActID:         stale.ID,
+		Version:       "9",
+		LatestActID:   second.ID,
+		LatestVersion: "10",
+	}, conflicts[0])
+	assert.Contains(t, conflicts[0].Error(), "already wrote version 10")
+
+	// Non-numeric versions compare lexically
+	conv.Acts = []ConversationAct{
+		NewVersionedFact("agent_123", order, "2025-01-15T14:30:00Z", "status", "confirmed"),
+		NewVersionedFact("agent_123", order, "2025-01-15T15:00:00Z", "status", "shipped"),
+	}
+	assert.Empty(t, conv.CheckEntityVersions())
+}
+
+func TestConversationPendingConfirmations(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}
+	conv := NewConversation(participants)
+	order := NewEntity("order_789", "order")
+
+	orderConfirm := NewConfirm("agent_123", "order_789", "Two large pizzas for delivery?",
+		WithAwaiting(true))
+	addressConfirm := NewConfirm("agent_123", "address_001", "Deliver to 12 Main St?",
+		WithAwaiting(true))
+	require.NoError(t, conv.AddAct(orderConfirm))
+	require.NoError(t, conv.AddAct(addressConfirm))
+
+	pending := conv.PendingConfirmations()
+	require.Len(t, pending, 2)
+	assert.Equal(t, orderConfirm.ID, pending[0].ID)
+	assert.Equal(t, addressConfirm.ID, pending[1].ID)
+
+	// The customer accepts the order using a structured entity reference
+	orderAnswer := NewConfirm("customer_456", order, "Yes, that's right", WithConfirmed(true))
+	require.NoError(t, conv.AddAct(orderAnswer))
+	pending = conv.PendingConfirmations()
+	require.Len(t, pending, 1)
+	assert.Equal(t, addressConfirm.ID, pending[0].ID)
+
+	latest, ok := conv.LatestConfirmationFor("order_789")
+	require.True(t, ok)
+	assert.Equal(t, orderAnswer.ID, latest.ID)
+
+	// A fact about the address resolves its confirmation
+	require.NoError(t, conv.AddAct(NewFact("customer_456", "address_001", "street", "14 Main St")))
+	assert.Empty(t, conv.PendingConfirmations())
+
+	// Asking again opens a new pending confirmation
+	reconfirm := NewConfirm("agent_123", "address_001", "Deliver to 14 Main St?", WithAwaiting(true))
+	require.NoError(t, conv.AddAct(reconfirm))
+	pending = conv.PendingConfirmations()
+	require.Len(t, pending, 1)
+	assert.Equal(t, reconfirm.ID, pending[0].ID)
+
+	latest, ok = conv.LatestConfirmationFor("address_001")
+	require.True(t, ok)
+	assert.Equal(t, reconfirm.ID, latest.ID)
+
+	_, ok = conv.LatestConfirmationFor("order_missing")
+	assert.False(t, ok)
+}
+
+func TestConversationConfirmedFields(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	confirm := func(entity EntityRef, confirmed bool, fields ...string) Confirm {
+		c := NewConfirm("customer_456", entity, "Confirming "+strings.Join(fields, ", "), WithConfirmed(confirmed))
+		c.FieldsConfirmed = fields
+		if !confirmed {
+			reason := "That's wrong"
+			c.RejectionReason = &reason
+		}
+		return c
+	}
+
+	size := NewFact("customer_456", "order_789", "size", "large")
+	quantity := NewFact("customer_456", "order_789", "quantity", 2)
+	address := NewFact("customer_456", NewEntity("order_789", "order"), "address", "12 Main St")
+	tip := NewFact("customer_456", "order_789", "tip", 5)
+	otherEntity := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	acts := []ConversationAct{
+		size, quantity, address, tip, otherEntity,
+		confirm("order_789", true, "size", "quantity"),
+		confirm(NewEntity("order_789", "order"), false, "address"),
+		confirm("order_789", true),
+		confirm("customer_456", true, "tip"),
+	}
+	for _, act := range acts {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	// Only accepted confirms that name fields count
+	assert.Equal(t, map[string]bool{"size": true, "quantity": true}, conv.ConfirmedFields("order_789"))
+	assert.Equal(t, []Fact{address, tip}, conv.UnconfirmedFacts("order_789"))
+
+	// A changed value needs confirming again
+	newQuantity := NewFact("customer_456", "order_789", "quantity", 3)
+	require.NoError(t, conv.AddAct(newQuantity))
+	assert.Equal(t, []Fact{address, tip, newQuantity}, conv.UnconfirmedFacts("order_789"))
+	assert.True(t, conv.ConfirmedFields("order_789")["quantity"])
+
+	require.NoError(t, conv.AddAct(confirm("order_789", true, "quantity", "address", "tip")))
+	assert.Empty(t, conv.UnconfirmedFacts("order_789"))
+	assert.Equal(t, map[string]bool{"size": true, "quantity": true, "address": true, "tip": true}, conv.ConfirmedFields("order_789"))
+	assert.Empty(t, conv.ConfirmedFields("unknown_1"))
+}
+
+func TestConversationConfidenceFiltering(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}
+	conv := NewConversation(participants)
+
+	scored := func(field string, value interface{}, confidence float64) Fact {
+		return NewFact("customer_456", "order_789", field, value, WithAct[Fact](WithConfidence(confidence)))
+	}
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	unsure := scored("email", "user@exmple.com", 0.4)
+	sure := scored("quantity", 2, 0.95)
+	borderline := scored("size", "large", 0.8)
+	for _, act := range []ConversationAct{ask, unsure, sure, borderline} {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	// Unscored acts are not flagged by default
+	below := conv.ActsBelowConfidence(0.8)
+	require.Len(t, below, 1)
+	assert.Equal(t, unsure.ID, below[0].GetAct().ID)
+
+	below = conv.ActsBelowConfidence(0.8, IncludeUnscored(false))
+	require.Len(t, below, 2)
+	assert.Equal(t, ask.ID, below[0].GetAct().ID)
+	assert.Equal(t, unsure.ID, below[1].GetAct().ID)
+
+	filtered := conv.FilterByConfidence(0.8)
+	require.Len(t, filtered.Acts, 3)
+	assert.Equal(t, ask.ID, filtered.Acts[0].GetAct().ID)
+	assert.Equal(t, sure.ID, filtered.Acts[1].GetAct().ID)
+	assert.Equal(t, borderline.ID, filtered.Acts[2].GetAct().ID)
+	assert.Equal(t, 3, *filtered.Metadata.ActCount)
+
+	filtered = conv.FilterByConfidence(0.8, IncludeUnscored(false))
+	assert.Len(t, filtered.Acts, 2)
+
+	// The original conversation is left untouched
+	assert.Len(t, conv.Acts, 4)
+	assert.Equal(t, 4, *conv.Metadata.ActCount)
+}
+
+func TestConversationTimeBudget(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+	}
+	startedAt := time.Date(2025, time.January, 15, 14, 30, 0, 0, time.UTC)
+
+	conv := NewConversation(participants, WithTimeBudget(60000))
+	conv.StartedAt = &startedAt
+	require.NotNil(t, conv.TimeBudgetMs)
+	assert.Equal(t, int64(60000), *conv.TimeBudgetMs)
+
+	// Within budget
+	assert.False(t, conv.IsOverBudget(startedAt.Add(30*time.Second)))
+	assert.False(t, conv.IsOverBudget(startedAt.Add(60*time.Second)))
+
+	// Over budget
+	assert.True(t, conv.IsOverBudget(startedAt.Add(61*time.Second)))
+
+	// Ended conversations are measured up to EndedAt
+	endedAt := startedAt.Add(45 * time.Second)
+	conv.EndedAt = &endedAt
+	assert.False(t, conv.IsOverBudget(startedAt.Add(time.Hour)))
+
+	// No budget means never over budget
+	unbounded := NewConversation(participants)
+	assert.Nil(t, unbounded.TimeBudgetMs)
+	assert.False(t, unbounded.IsOverBudget(time.Now().Add(24*time.Hour)))
+}
+
+func TestSafeConversationConcurrentAddAct(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}
+	safe := NewSafeConversation(NewConversation(participants))
+
+	const writers, actsPerWriter = 16, 50
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < actsPerWriter; j++ {
+				var act ConversationAct = NewAsk("agent_123", "email", "What's your email?")
+				if j%2 == 1 {
+					act = NewFact("customer_456", "order_789", "email", "user@example.com")
+				}
+				assert.NoError(t, safe.AddAct(act))
+			}
+		}(i)
+
+		// Readers run alongside the writers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < actsPerWriter; j++ {
+				safe.GetActsByType(ActTypeAsk)
+				safe.Stats()
+				_, _ = safe.GetActByID("act_missing")
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := safe.Stats()
+	assert.Equal(t, writers*actsPerWriter, stats.TotalActs)
+	assert.Len(t, safe.GetActsByType(ActTypeAsk), writers*actsPerWriter/2)
+
+	snapshot := safe.Snapshot()
+	require.Len(t, snapshot.Acts, writers*actsPerWriter)
+	require.NotNil(t, snapshot.Metadata)
+	assert.Equal(t, writers*actsPerWriter, *snapshot.Metadata.ActCount)
+
+	found, err := safe.GetActByID(snapshot.Acts[0].GetAct().ID)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.Acts[0].GetAct().ID, found.GetAct().ID)
 }
 
 // ============================================================================
@@ -776,24 +2413,320 @@ func TestConstraintBuilders(t *testing.T) {
 	assert.True(t, *rangeValue.Inclusive)
 }
 
-// ============================================================================
-// Schema Validation Tests
-// ============================================================================
+func TestFormatValidation(t *testing.T) {
+	assert.True(t, IsValidEmail("user@example.com"))
+	assert.True(t, IsValidEmail("first.last+tag@mail.example.co.uk"))
+	assert.False(t, IsValidEmail("user name@example.com"))
+	assert.False(t, IsValidEmail("user@example"))
+	assert.False(t, IsValidEmail("user@"))
+	assert.False(t, IsValidEmail(""))
+
+	assert.True(t, IsValidPhoneE164("+14155552671"))
+	assert.False(t, IsValidPhoneE164("(415) 555-2671"))
+	assert.False(t, IsValidPhoneE164("14155552671"))
+	assert.False(t, IsValidPhoneE164("+0123456"))
 
-func TestGetSchema(t *testing.T) {
 	tests := []struct {
-		name        string
-		schemaName  string
-		shouldError bool
+		input     string
+		expected  string
+		wantError bool
 	}{
-		{"Valid schema - act", "act", false},
-		{"Valid schema - ask", "ask", false},
-		{"Valid schema - fact", "fact", false},
-		{"Valid schema - confirm", "confirm", false},
-		{"Valid schema - commit", "commit", false},
-		{"Valid schema - error", "error", false},
-		{"Valid schema - entity", "entity", false},
-		{"Valid schema - participant", "participant", false},
+		{"+14155552671", "+14155552671", false},
+		{"(415) 555-2671", "+14155552671", false},
+		{"1-415-555-2671", "+14155552671", false},
+		{"+44 20 7946 0958", "+442079460958", false},
+		{"0044 20 7946 0958", "+442079460958", false},
+		{"555-2671", "", true},
+		{"+1 415 CALL NOW", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			normalized, err := NormalizePhone(tt.input)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestLocalizedMessages(t *testing.T) {
+	RegisterMessageCatalog("es", MessageCatalog{
+		MessageRequired:      "Este campo es obligatorio",
+		MessageMinLength:     "La longitud mínima es de %d caracteres",
+		MessageValueRequired: "el valor es obligatorio",
+	})
+	RegisterMessageCatalog("fr", MessageCatalog{MessageRequired: "Ce champ est obligatoire"})
+	defer SetMessageLocale("")
+
+	assert.Equal(t, "This field is required", *RequiredConstraint().Message)
+
+	SetMessageLocale("es")
+	assert.Equal(t, "es", MessageLocale())
+	assert.Equal(t, "Este campo es obligatorio", *RequiredConstraint().Message)
+	assert.Equal(t, "La longitud mínima es de 5 caracteres", *MinLengthConstraint(5).Message)
+	// Untranslated messages fall back to English
+	assert.Equal(t, "Must be a valid email address", *EmailFormatConstraint().Message)
+
+	required := NewConstraint(ConstraintTypeRequired)
+	violations := EvaluateConstraints("", []Constraint{required})
+	require.Len(t, violations, 1)
+	assert.Equal(t, "el valor es obligatorio", violations[0].Message)
+
+	SetMessageLocale("fr")
+	assert.Equal(t, "Ce champ est obligatoire", *RequiredConstraint().Message)
+
+	// Participants' preferred language wins, with regions falling back to the language
+	customer := NewParticipant("customer_456", ParticipantTypeHuman)
+	assert.Equal(t, "fr", customer.MessageLocale())
+	language := "es-MX"
+	customer.Preferences = &ParticipantPreferences{Language: &language}
+	assert.Equal(t, "es-MX", customer.MessageLocale())
+	assert.Equal(t, "Este campo es obligatorio", LocalizeMessage(customer.MessageLocale(), MessageRequired))
+
+	violations = EvaluateConstraintsInLocale("en", "", []Constraint{required})
+	require.Len(t, violations, 1)
+	assert.Equal(t, "value is required", violations[0].Message)
+
+	// Builder messages are rendered again in the locale passed at evaluation
+	// time, not the one set when the constraint was built
+	SetMessageLocale("")
+	built := []Constraint{RequiredConstraint(), MinLengthConstraint(5)}
+	assert.Equal(t, "This field is required", *built[0].Message)
+	violations = EvaluateConstraintsInLocale(customer.MessageLocale(), nil, built)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "Este campo es obligatorio", violations[0].Message)
+	violations = EvaluateConstraintsInLocale(customer.MessageLocale(), "abc", built)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "La longitud mínima es de 5 caracteres", violations[0].Message)
+	violations = EvaluateConstraints("abc", built)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "Minimum length is 5 characters", violations[0].Message)
+
+	// Serialized constraints carry the text they were built with
+	data, err := json.Marshal(built)
+	require.NoError(t, err)
+	var decoded []Constraint
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	violations = EvaluateConstraintsInLocale(customer.MessageLocale(), nil, decoded)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "This field is required", violations[0].Message)
+
+	// A caller's own message is used in every locale, and codes are not
+	// mistaken for message keys
+	overridden := RequiredConstraint()
+	custom := "Please tell us"
+	overridden.Message = &custom
+	coded := NewConstraint(ConstraintTypeRequired, WithConstraintCode(string(MessageRequired)))
+	violations = EvaluateConstraintsInLocale("es", nil, []Constraint{overridden, coded})
+	require.Len(t, violations, 2)
+	assert.Equal(t, "Please tell us", violations[0].Message)
+	assert.Equal(t, "el valor es obligatorio", violations[1].Message)
+}
+
+func TestEvaluateConstraints(t *testing.T) {
+	min, max := 1.0, 10.0
+	constraints := []Constraint{
+		RequiredConstraint(),
+		MinLengthConstraint(5),
+		EmailFormatConstraint(),
+	}
+
+	assert.Empty(t, EvaluateConstraints("user@example.com", constraints))
+
+	violations := EvaluateConstraints("a@b", constraints)
+	require.Len(t, violations, 2)
+	assert.Equal(t, ConstraintTypeMinLength, violations[0].Constraint.Type)
+	assert.Equal(t, ConstraintTypeFormat, violations[1].Constraint.Type)
+	assert.Equal(t, "Must be a valid email address", violations[1].Message)
+
+	assert.Len(t, EvaluateConstraints("", []Constraint{RequiredConstraint()}), 1)
+	assert.Empty(t, EvaluateConstraints("+14155552671", []Constraint{PhoneFormatConstraint()}))
+	assert.Len(t, EvaluateConstraints("(415) 555-2671", []Constraint{PhoneFormatConstraint()}), 1)
+	assert.Empty(t, EvaluateConstraints("small", []Constraint{EnumConstraint([]string{"small", "large"})}))
+	assert.Len(t, EvaluateConstraints("medium", []Constraint{EnumConstraint([]string{"small", "large"})}), 1)
+	assert.Empty(t, EvaluateConstraints(5, []Constraint{RangeConstraint(&min, &max, true)}))
+	assert.Len(t, EvaluateConstraints(10.0, []Constraint{RangeConstraint(&min, &max, false)}), 1)
+	assert.Len(t, EvaluateConstraints("abc", []Constraint{
+		NewConstraint(ConstraintTypePattern, WithConstraintValue(`^[0-9]+$`)),
+	}), 1)
+}
+
+func TestConstraintSchemaConversion(t *testing.T) {
+	constraints := []Constraint{
+		MinLengthConstraint(5),
+		MaxLengthConstraint(100),
+		EmailFormatConstraint(),
+		EnumConstraint([]string{"sales@example.com", "support@example.com"}),
+	}
+
+	schema := ConstraintsToSchema(constraints, ExpectedTypeEmail)
+	assert.Equal(t, Schema{
+		"type":      "string",
+		"format":    "email",
+		"minLength": 5,
+		"maxLength": 100,
+		"enum":      []interface{}{"sales@example.com", "support@example.com"},
+	}, schema)
+	assert.Equal(t, constraints, SchemaToConstraints(schema))
+
+	// The fragment survives JSON, as it would on its way to a form generator
+	data, err := json.Marshal(schema)
+	require.NoError(t, err)
+	var decoded Schema
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, constraints, SchemaToConstraints(decoded))
+
+	// Ranges, patterns, and array lengths; the tighter of two bounds is kept
+	min, max, tighterMax := 1.0, 10.0, 8.0
+	schema = ConstraintsToSchema([]Constraint{
+		RangeConstraint(&min, &max, true),
+		RangeConstraint(nil, &tighterMax, false),
+		NewConstraint(ConstraintTypePattern, WithConstraintValue(`^[0-9]+$`)),
+		RequiredConstraint(),
+	}, ExpectedTypeNumber)
+	assert.Equal(t, Schema{
+		"type":             "number",
+		"minimum":          1.0,
+		"maximum":          10.0,
+		"exclusiveMaximum": 8.0,
+		"pattern":          `^[0-9]+$`,
+	}, schema)
+	assert.Equal(t, []Constraint{
+		NewConstraint(ConstraintTypePattern, WithConstraintValue(`^[0-9]+$`)),
+		RangeConstraint(&min, &max, true),
+		RangeConstraint(nil, &tighterMax, false),
+	}, SchemaToConstraints(schema))
+
+	assert.Equal(t, Schema{"type": "array", "minItems": 1, "maxItems": 3},
+		ConstraintsToSchema([]Constraint{MinLengthConstraint(1), MaxLengthConstraint(3), MinLengthConstraint(0)}, ExpectedTypeArray))
+	assert.Equal(t, Schema{"type": []string{"string", "object"}}, ConstraintsToSchema(nil, ExpectedTypeAddress))
+	assert.Equal(t, Schema{"format": "uri"}, ConstraintsToSchema([]Constraint{
+		NewConstraint(ConstraintTypeFormat, WithConstraintValue("url")),
+	}, ""))
+	assert.Equal(t, []Constraint{NewConstraint(ConstraintTypeFormat, WithConstraintValue(FormatTypeURL))},
+		SchemaToConstraints(Schema{"format": "uri"}))
+
+	// Mixed enums keep their values; unknown formats are dropped
+	assert.Equal(t, []Constraint{NewConstraint(ConstraintTypeEnum, WithConstraintValue([]interface{}{1.0, "two"}))},
+		SchemaToConstraints(Schema{"enum": []interface{}{1.0, "two"}, "format": "hostname"}))
+}
+
+func TestCoerceValue(t *testing.T) {
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     interface{}
+		expected  ExpectedType
+		want      interface{}
+		wantError bool
+	}{
+		{"Numeric string to number", "42", ExpectedTypeNumber, 42.0, false},
+		{"Int to number", 7, ExpectedTypeNumber, 7.0, false},
+		{"Word to number", "forty two", ExpectedTypeNumber, nil, true},
+		{"Yes to boolean", "Yes", ExpectedTypeBoolean, true, false},
+		{"False to boolean", "false", ExpectedTypeBoolean, false, false},
+		{"Maybe to boolean", "maybe", ExpectedTypeBoolean, nil, true},
+		{"RFC3339 to date", "2025-01-15T00:00:00Z", ExpectedTypeDate, date, false},
+		{"Calendar date to date", "2025-01-15", ExpectedTypeDate, date, false},
+		{"Invalid date", "next tuesday", ExpectedTypeDate, nil, true},
+		{"Valid email", " user@example.com ", ExpectedTypeEmail, "user@example.com", false},
+		{"Invalid email", "user at example", ExpectedTypeEmail, nil, true},
+		{"Valid phone", "+1 (555) 123-4567", ExpectedTypePhone, "+15551234567", false},
+		{"Invalid phone", "call me", ExpectedTypePhone, nil, true},
+		{"Number to string", 3.5, ExpectedTypeString, "3.5", false},
+		{"String to object", "order", ExpectedTypeObject, nil, true},
+		{"Unknown expected type", "x", ExpectedType("color"), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CoerceValue(tt.value, tt.expected)
+			if tt.wantError {
+				var coercionErr CoercionError
+				assert.ErrorAs(t, err, &coercionErr)
+				assert.Equal(t, tt.expected, coercionErr.ExpectedType)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Schema Validation Tests
+// ============================================================================
+
+func TestConversationValidateAnswers(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	min, max := 1.0, 10.0
+	askEmail := NewAsk("agent_123", "email", "What's your email?", WithExpectedType(ExpectedTypeEmail))
+	askQuantity := NewAsk("agent_123", "quantity", "How many?",
+		WithExpectedType(ExpectedTypeNumber),
+		WithConstraints([]Constraint{RangeConstraint(&min, &max, true)}))
+	askSize := NewAsk("agent_123", "size", "Small or large?",
+		WithConstraints([]Constraint{EnumConstraint([]string{"small", "large"})}))
+	askName := NewAsk("agent_123", "name", "What's your name?", WithExpectedType(ExpectedTypeString))
+	badEmail := NewFact("customer_456", "customer_456", "email", "jane at example")
+	quantity := NewFact("customer_456", "order_789", "quantity", "12")
+	size := NewFact("customer_456", "order_789", "size", "medium")
+	name := NewFact("customer_456", "customer_456", "name", "Jane")
+	conv.Acts = []ConversationAct{askEmail, askQuantity, askSize, askName, badEmail, quantity, size, name}
+
+	errs := conv.ValidateAnswers()
+	require.Len(t, errs, 3)
+
+	var answerErr AnswerError
+	require.ErrorAs(t, errs[0], &answerErr)
+	assert.Equal(t, AnswerError{AskID: askEmail.ID, FactID: badEmail.ID, Field: "email", Err: answerErr.Err}, answerErr)
+	var coercionErr CoercionError
+	assert.ErrorAs(t, errs[0], &coercionErr)
+	assert.Contains(t, errs[0].Error(), badEmail.ID)
+	assert.Contains(t, errs[0].Error(), askEmail.ID)
+
+	// The numeric string is coerced before it is range-checked
+	var violation ConstraintViolation
+	require.ErrorAs(t, errs[1], &violation)
+	assert.Equal(t, ConstraintTypeRange, violation.Constraint.Type)
+	assert.Equal(t, 12.0, violation.Value)
+	require.ErrorAs(t, errs[1], &answerErr)
+	assert.Equal(t, quantity.ID, answerErr.FactID)
+
+	require.ErrorAs(t, errs[2], &violation)
+	assert.Equal(t, ConstraintTypeEnum, violation.Constraint.Type)
+	require.ErrorAs(t, errs[2], &answerErr)
+	assert.Equal(t, askSize.ID, answerErr.AskID)
+
+	// Each ask is checked against its first answer only, so a later
+	// correction does not clear the problem
+	conv.Acts = append(conv.Acts, NewFact("customer_456", "customer_456", "email", "jane@example.com"))
+	assert.Len(t, conv.ValidateAnswers(), 3)
+
+	conv.Acts = []ConversationAct{askEmail, askName, NewFact("customer_456", "customer_456", "email", " jane@example.com ")}
+	assert.Empty(t, conv.ValidateAnswers())
+}
+
+func TestGetSchema(t *testing.T) {
+	tests := []struct {
+		name        string
+		schemaName  string
+		shouldError bool
+	}{
+		{"Valid schema - act", "act", false},
+		{"Valid schema - ask", "ask", false},
+		{"Valid schema - fact", "fact", false},
+		{"Valid schema - confirm", "confirm", false},
+		{"Valid schema - commit", "commit", false},
+		{"Valid schema - error", "error", false},
+		{"Valid schema - entity", "entity", false},
+		{"Valid schema - participant", "participant", false},
 		{"Valid schema - constraint", "constraint", false},
 		{"Valid schema - conversation", "conversation", false},
 		{"Invalid schema", "invalid", true},
@@ -847,6 +2780,460 @@ func TestValidateJSON(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestValidateJSONFormats(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaName string
+		json       string
+		wantErr    string
+	}{
+		{"valid email", "participant", `{"id": "customer_456", "type": "human", "email": "jane@example.com"}`, ""},
+		{"invalid email", "participant", `{"id": "customer_456", "type": "human", "email": "jane@"}`, "not a valid email"},
+		{"valid uri", "entity", `{"id": "order_789", "type": "order", "schema_url": "https://schemas.example.com/order.json"}`, ""},
+		{"invalid uri", "entity", `{"id": "order_789", "type": "order", "schema_url": "order.json"}`, "not a valid uri"},
+		{"invalid date-time", "ask", `{"id": "act_123", "timestamp": "yesterday", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "Email?"}`, "not a valid date-time"},
+		{"invalid pattern", "ask", `{"id": "action_123", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "Email?"}`, "does not match pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSON([]byte(tt.json), tt.schemaName)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	// Patterns outside the embedded schemas are compiled once and reused
+	validator := newSchemaValidator()
+	first, err := validator.pattern(`^order_[0-9]+$`)
+	require.NoError(t, err)
+	second, err := validator.pattern(`^order_[0-9]+$`)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	_, err = validator.pattern(`(`)
+	assert.Error(t, err)
+}
+
+func TestValidateJSONRejectsUnknownProperties(t *testing.T) {
+	err := ValidateJSON([]byte(`{"type":"ask","field":"x","prompt":"y","bogus":1}`), "ask")
+	assert.ErrorContains(t, err, "unknown properties: bogus")
+
+	typo := `{
+		"id": "act_123",
+		"timestamp": "2025-01-15T14:30:00Z",
+		"speaker": "agent_123",
+		"type": "ask",
+		"field": "email",
+		"prompt": "What's your email?",
+		"prmopt": "What's your email?",
+		"extra": true
+	}`
+	assert.ErrorContains(t, ValidateJSON([]byte(typo), "ask"), "unknown properties: extra, prmopt")
+
+	// Metadata stays open to arbitrary keys
+	withMetadata := `{
+		"id": "act_123",
+		"timestamp": "2025-01-15T14:30:00Z",
+		"speaker": "agent_123",
+		"type": "ask",
+		"field": "email",
+		"prompt": "What's your email?",
+		"metadata": {"turn": 3, "campaign": "spring"}
+	}`
+	assert.NoError(t, ValidateJSON([]byte(withMetadata), "ask"))
+}
+
+func TestValidateJSONNestedRequirements(t *testing.T) {
+	validConversation := `{
+		"id": "conv_123",
+		"participants": [
+			{"id": "agent_123", "type": "ai"},
+			{"id": "customer_456", "type": "human", "preferences": {"language": "en-US"}}
+		],
+		"acts": [
+			{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "fact",
+			 "entity": {"id": "order_789", "type": "order"}, "field": "email", "value": "jane@example.com"}
+		],
+		"metadata": {"act_count": 1}
+	}`
+	assert.NoError(t, ValidateJSON([]byte(validConversation), "conversation"))
+
+	tests := []struct {
+		name       string
+		schemaName string
+		json       string
+		wantErr    string
+	}{
+		{
+			"participant without type", "conversation",
+			`{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai"}, {"id": "customer_456"}], "acts": []}`,
+			"property participants: item 1: required field missing: type",
+		},
+		{
+			"participant with invalid email", "conversation",
+			`{"id": "conv_123", "participants": [{"id": "customer_456", "type": "human", "email": "jane@"}], "acts": []}`,
+			"property participants: item 0: validation failed for property email",
+		},
+		{
+			"structured entity without id", "fact",
+			`{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "fact",
+			  "entity": {"type": "order"}, "field": "email", "value": "jane@example.com"}`,
+			"property entity: required field missing: id",
+		},
+		{
+			"entity of wrong type", "fact",
+			`{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "fact",
+			  "entity": 42, "field": "email", "value": "jane@example.com"}`,
+			"does not match any oneOf schema",
+		},
+		{
+			"commit error without message", "commit",
+			`{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "system_001", "type": "commit",
+			  "entity": "order_789", "action": "create", "status": "failed", "error": {"code": "TIMEOUT"}}`,
+			"property error: required field missing: message",
+		},
+		{
+			"nested act with structured entity without id", "conversation",
+			`{"id": "conv_123", "participants": [{"id": "customer_456", "type": "human"}], "acts": [
+				{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "customer_456", "type": "fact",
+				 "entity": {"type": "order"}, "field": "email", "value": "jane@example.com"}
+			]}`,
+			"property acts: item 0: validation failed for property entity: required field missing: id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateJSON([]byte(tt.json), tt.schemaName), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateJSONArrays(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{
+		{
+			"no participants",
+			`{"id": "conv_123", "participants": [], "acts": []}`,
+			"property participants: array has 0 items, fewer than minimum 1",
+		},
+		{
+			"participant that is not an object",
+			`{"id": "conv_123", "participants": ["agent_123"], "acts": []}`,
+			"property participants: item 0: expected type object, got string",
+		},
+		{
+			"capability that is not a string",
+			`{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai", "capabilities": ["lookup", 7]}], "acts": []}`,
+			"item 0: validation failed for property capabilities: item 1: expected type string, got float64",
+		},
+		{
+			"act that is not an object",
+			`{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai"}], "acts": [42]}`,
+			"property acts: item 0: value does not match any oneOf schema",
+		},
+		{
+			"act of unknown type",
+			`{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai"}], "acts": [
+				{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "greet"}
+			]}`,
+			"property acts: item 0: value does not match any oneOf schema",
+		},
+		{
+			"act failing its own schema",
+			`{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai"}], "acts": [
+				{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email"}
+			]}`,
+			"property acts: item 0: required field missing: prompt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateJSON([]byte(tt.json), "conversation"), tt.wantErr)
+		})
+	}
+
+	schema := Schema{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"tags": map[string]interface{}{"type": "array", "maxItems": 2},
+		},
+	}
+	assert.NoError(t, validateAgainstSchema(map[string]interface{}{"tags": []interface{}{"a", "b"}}, schema))
+	assert.ErrorContains(t, validateAgainstSchema(map[string]interface{}{"tags": []interface{}{"a", "b", "c"}}, schema),
+		"array has 3 items, more than maximum 2")
+}
+
+func TestWriteSchemas(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "schemas")
+	require.NoError(t, WriteSchemas(dir))
+
+	for _, name := range ListSchemas() {
+		data, err := os.ReadFile(filepath.Join(dir, name+".json"))
+		require.NoError(t, err, name)
+
+		var written map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &written), name)
+		assert.Equal(t, "https://schemas.astra.dev/v1/"+name+".json", written["$id"])
+
+		canonical, err := SchemaJSON(name)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(canonical), string(data))
+	}
+
+	// The acts oneOf survives as a list of $ref objects
+	data, err := SchemaJSON("conversation")
+	require.NoError(t, err)
+	var conversation struct {
+		Properties struct {
+			Acts struct {
+				Items struct {
+					OneOf []map[string]string `json:"oneOf"`
+				} `json:"items"`
+			} `json:"acts"`
+		} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(data, &conversation))
+	oneOf := conversation.Properties.Acts.Items.OneOf
+	require.Len(t, oneOf, 5)
+	assert.Equal(t, "#/$defs/ask", oneOf[0]["$ref"])
+
+	// Canonical output is stable
+	again, err := SchemaJSON("conversation")
+	require.NoError(t, err)
+	assert.Equal(t, data, again)
+
+	_, err = SchemaJSON("unknown")
+	assert.Error(t, err)
+}
+
+func TestResolvedConversationSchema(t *testing.T) {
+	resolved := ResolvedConversationSchema()
+
+	data, err := json.Marshal(resolved)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "#/definitions/")
+
+	// Every $ref points at a definition inside the document
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &document))
+	defs, ok := document["$defs"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Len(t, defs, 5)
+	var refs []string
+	var collect func(node interface{})
+	collect = func(node interface{}) {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for key, child := range n {
+				if ref, ok := child.(string); ok && key == "$ref" {
+					refs = append(refs, ref)
+				}
+				collect(child)
+			}
+		case []interface{}:
+			for _, child := range n {
+				collect(child)
+			}
+		}
+	}
+	collect(document)
+	require.Len(t, refs, 5)
+	for _, ref := range refs {
+		require.True(t, strings.HasPrefix(ref, "#/$defs/"), ref)
+		def, ok := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+		require.True(t, ok, ref)
+		assert.NotContains(t, def, "$schema")
+		assert.Equal(t, "object", def["type"])
+	}
+
+	// The resolved schema, as published, validates a known-good conversation
+	// and rejects a bad act
+	var conversation interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "conv_123",
+		"participants": [{"id": "agent_123", "type": "ai"}],
+		"acts": [{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "Email?"}]
+	}`), &conversation))
+	assert.NoError(t, validateAgainstSchema(conversation, Schema(document)))
+	assert.NoError(t, validateAgainstSchema(conversation, resolved))
+
+	// Its refs resolve only through the inlined $defs
+	document["$defs"] = map[string]interface{}{}
+	assert.Error(t, validateAgainstSchema(conversation, Schema(document)))
+	delete(document, "$defs")
+	assert.Error(t, validateAgainstSchema(conversation, Schema(document)))
+
+	conversation.(map[string]interface{})["acts"].([]interface{})[0].(map[string]interface{})["prompt"] = 42.0
+	assert.ErrorContains(t, validateAgainstSchema(conversation, resolved), "property prompt")
+
+	// The embedded schema is left untouched
+	embedded, err := json.Marshal(Schemas.Conversation)
+	require.NoError(t, err)
+	assert.Contains(t, string(embedded), "#/definitions/ask")
+	assert.NotContains(t, Schemas.Conversation, "$defs")
+}
+
+func TestConversationValidateAgainstSchema(t *testing.T) {
+	conversation := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	for _, actType := range []ActType{ActTypeAsk, ActTypeFact, ActTypeConfirm, ActTypeCommit, ActTypeError} {
+		conversation.AddAct(ExampleAct(actType))
+	}
+	require.NoError(t, conversation.ValidateAgainstSchema())
+
+	// Rules that only the schemas define are enforced
+	fact := conversation.Acts[1].(Fact)
+	confidence := 1.5
+	fact.Confidence = &confidence
+	invalid := conversation.Clone()
+	invalid.Acts[1] = fact
+	assert.ErrorContains(t, invalid.ValidateAgainstSchema(), "property confidence")
+
+	invalid = conversation.Clone()
+	invalid.ID = "conversation_123"
+	assert.ErrorContains(t, invalid.ValidateAgainstSchema(), "conversation conversation_123 does not match schema")
+
+	// Values outside a schema enum are rejected
+	ivr := Source("ivr")
+	ask := CloneAct(conversation.Acts[0]).(Ask)
+	ask.Source = &ivr
+	invalid = conversation.Clone()
+	invalid.Acts[0] = ask
+	assert.ErrorContains(t, invalid.ValidateAgainstSchema(), "value ivr not in enum")
+
+	bogus := ExpectedType("bogus")
+	ask = CloneAct(conversation.Acts[0]).(Ask)
+	ask.ExpectedType = &bogus
+	invalid = conversation.Clone()
+	invalid.Acts[0] = ask
+	assert.ErrorContains(t, invalid.ValidateAgainstSchema(), "value bogus not in enum")
+
+	// Every property a schema requires is always serialized by the matching Go
+	// type: a required field that is missing, renamed, or omitempty means the
+	// struct and schema have drifted apart
+	types := map[string]reflect.Type{
+		"act":          reflect.TypeOf(Act{}),
+		"ask":          reflect.TypeOf(Ask{}),
+		"fact":         reflect.TypeOf(Fact{}),
+		"confirm":      reflect.TypeOf(Confirm{}),
+		"commit":       reflect.TypeOf(Commit{}),
+		"error":        reflect.TypeOf(Error{}),
+		"entity":       reflect.TypeOf(Entity{}),
+		"participant":  reflect.TypeOf(Participant{}),
+		"constraint":   reflect.TypeOf(Constraint{}),
+		"conversation": reflect.TypeOf(Conversation{}),
+	}
+	for _, name := range ListSchemas() {
+		goType, ok := types[name]
+		require.True(t, ok, "no Go type registered for schema %s", name)
+		schema, err := GetSchema(name)
+		require.NoError(t, err)
+
+		fields := serializedFields(goType)
+		for _, required := range schema["required"].([]string) {
+			omitEmpty, ok := fields[required]
+			if assert.True(t, ok, "%s: schema requires %s but %s has no such field", name, required, goType.Name()) {
+				assert.False(t, omitEmpty, "%s: schema requires %s but %s omits it when empty", name, required, goType.Name())
+			}
+		}
+	}
+}
+
+// serializedFields returns the JSON names of a struct's fields, including
+// those of embedded structs, and whether each is tagged omitempty
+func serializedFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name, omitEmpty := range serializedFields(field.Type) {
+				fields[name] = omitEmpty
+			}
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = strings.Contains(options, "omitempty")
+	}
+	return fields
+}
+
+func TestValidateFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	validPath := filepath.Join(dir, "valid_ask.json")
+	validAskJSON := `{
+		"id": "act_123",
+		"timestamp": "2025-01-15T14:30:00Z",
+		"speaker": "agent_123",
+		"type": "ask",
+		"field": "email",
+		"prompt": "What's your email?"
+	}`
+	require.NoError(t, os.WriteFile(validPath, []byte(validAskJSON), 0o644))
+
+	invalidPath := filepath.Join(dir, "invalid_conversation.json")
+	invalidConversationJSON := `{
+		"id": "conversation_123",
+		"participants": [{"id": "agent_123", "type": "ai"}],
+		"acts": []
+	}`
+	require.NoError(t, os.WriteFile(invalidPath, []byte(invalidConversationJSON), 0o644))
+
+	results := ValidateFiles([]string{validPath, invalidPath})
+
+	assert.NotContains(t, results, validPath)
+	require.Contains(t, results, invalidPath)
+	assert.Len(t, results[invalidPath], 1)
+	assert.Contains(t, results[invalidPath][0].Error(), "conversation")
+
+	missing := filepath.Join(dir, "missing.json")
+	results = ValidateFiles([]string{missing})
+	assert.Contains(t, results, missing)
+}
+
+func TestExampleActsValidate(t *testing.T) {
+	actTypes := []ActType{ActTypeAsk, ActTypeFact, ActTypeConfirm, ActTypeCommit, ActTypeError}
+
+	for _, actType := range actTypes {
+		t.Run(string(actType), func(t *testing.T) {
+			example := ExampleAct(actType)
+			require.NotNil(t, example)
+			assert.Equal(t, actType, example.GetType())
+
+			// Go validation path
+			assert.NoError(t, ValidateAct(example))
+
+			// JSON schema validation path
+			jsonData, err := MarshalAct(example)
+			require.NoError(t, err)
+			assert.NoError(t, ValidateJSON(jsonData, string(actType)))
+
+			// Examples are deterministic
+			again, err := MarshalAct(ExampleAct(actType))
+			require.NoError(t, err)
+			assert.JSONEq(t, string(jsonData), string(again))
+		})
+	}
+
+	assert.Nil(t, ExampleAct(ActType("unknown")))
+}
+
 func TestListSchemas(t *testing.T) {
 	schemas := ListSchemas()
 	expectedSchemas := []string{
@@ -980,6 +3367,55 @@ func TestParticipantPreferencesJSONMarshaling(t *testing.T) {
 	assert.Equal(t, prefs.AdditionalProperties["max_response_time"], unmarshaledPrefs.AdditionalProperties["max_response_time"])
 }
 
+func TestAdditionalPropertiesMatchExactFieldNames(t *testing.T) {
+	var metadata ActMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"channel":"sms","Channel":"voice","trace_id":"abc"}`), &metadata))
+	require.NotNil(t, metadata.Channel)
+	assert.Equal(t, "sms", *metadata.Channel)
+	assert.Equal(t, map[string]interface{}{"Channel": "voice", "trace_id": "abc"}, metadata.AdditionalProperties)
+
+	// A differently cased member does not set the typed field
+	var context ConversationContext
+	require.NoError(t, json.Unmarshal([]byte(`{"SESSION_ID":"s1"}`), &context))
+	assert.Nil(t, context.SessionID)
+	assert.Equal(t, map[string]interface{}{"SESSION_ID": "s1"}, context.AdditionalProperties)
+
+	var preferences ParticipantPreferences
+	assert.Error(t, json.Unmarshal([]byte(`{"language":7}`), &preferences))
+	assert.Error(t, json.Unmarshal([]byte(`["language"]`), &preferences))
+}
+
+func TestAdditionalPropertiesMarshaling(t *testing.T) {
+	// Unset typed fields are omitted rather than written as null
+	for _, value := range []interface{}{
+		ActMetadata{AdditionalProperties: map[string]interface{}{"trace_id": "abc"}},
+		ParticipantPreferences{AdditionalProperties: map[string]interface{}{"theme": "dark"}},
+		ConversationContext{AdditionalProperties: map[string]interface{}{"tenant": "acme"}},
+		ConversationMetadata{AdditionalProperties: map[string]interface{}{"region": "eu"}},
+	} {
+		data, err := json.Marshal(value)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "null", "%T", value)
+	}
+
+	channel := "sms"
+	data, err := json.Marshal(ActMetadata{Channel: &channel, AdditionalProperties: map[string]interface{}{"trace_id": "abc"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"channel":"sms","trace_id":"abc"}`, string(data))
+
+	// An additional property may not shadow a typed field, set or not
+	for _, value := range []interface{}{
+		ActMetadata{Channel: &channel, AdditionalProperties: map[string]interface{}{"channel": "voice"}},
+		ActMetadata{AdditionalProperties: map[string]interface{}{"language": "en"}},
+		ParticipantPreferences{AdditionalProperties: map[string]interface{}{"language": "en"}},
+		ConversationContext{AdditionalProperties: map[string]interface{}{"session_id": "s1"}},
+		ConversationMetadata{AdditionalProperties: map[string]interface{}{"act_count": 3}},
+	} {
+		_, err := json.Marshal(value)
+		assert.ErrorContains(t, err, "conflicts with a known field", "%T", value)
+	}
+}
+
 func TestConversationJSONMarshaling(t *testing.T) {
 	participants := []Participant{
 		NewParticipant("agent_123", ParticipantTypeAI, WithRole("agent")),
@@ -1019,33 +3455,621 @@ func TestConversationJSONMarshaling(t *testing.T) {
 	}
 }
 
-// ============================================================================
-// Benchmark Tests
-// ============================================================================
-
-func BenchmarkGenerateActID(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		GenerateActID()
+func TestMarshalConversationWithValidation(t *testing.T) {
+	participants := []Participant{
+		NewParticipant("agent_123", ParticipantTypeAI, WithRole("agent")),
+		NewParticipant("customer_456", ParticipantTypeHuman, WithRole("customer")),
 	}
+	conv := NewConversation(participants)
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
+	fact.Timestamp = ask.Timestamp.Add(time.Second)
+	require.NoError(t, conv.AddAct(ask))
+	require.NoError(t, conv.AddAct(fact))
+	assert.True(t, conv.IsChronological())
+
+	data, err := MarshalConversation(conv, true)
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalConversation(data, true)
+	require.NoError(t, err)
+	assert.Equal(t, conv.ID, decoded.ID)
+	require.Len(t, decoded.Acts, 2)
+
+	// Out-of-order acts and unknown speakers are reported together
+	stray := NewFact("bot_999", "order_789", "email", "other@example.com")
+	stray.Timestamp = ask.Timestamp.Add(-time.Minute)
+	conv.Acts = append(conv.Acts, stray)
+	assert.False(t, conv.IsChronological())
+
+	_, err = MarshalConversation(conv, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "speaker bot_999 is not a conversation participant")
+	assert.Contains(t, err.Error(), "timestamp precedes the previous act")
+
+	data, err = MarshalConversation(conv, false)
+	require.NoError(t, err)
+	_, err = UnmarshalConversation(data, true)
+	assert.Error(t, err)
+	_, err = UnmarshalConversation(data, false)
+	assert.NoError(t, err)
+
+	// An invalid act is not the act later acts are ordered against
+	conv.Acts = conv.Acts[:2]
+	late := NewAsk("agent_123", "", "What's your phone number?")
+	late.Timestamp = fact.Timestamp.Add(time.Hour)
+	next := NewAsk("agent_123", "phone", "What's your phone number?")
+	next.Timestamp = fact.Timestamp.Add(time.Minute)
+	conv.Acts = append(conv.Acts, late, next)
+
+	errs := conv.Validate()
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrMissingField)
+	assert.NotContains(t, errs[0].Error(), "timestamp precedes the previous act")
 }
 
-func BenchmarkGenerateConversationID(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		GenerateConversationID()
+func TestDecodeConversationDocument(t *testing.T) {
+	data := []byte(`{
+		"id": "conv_123",
+		"schema_version": "v1",
+		"participants": [
+			{"id": "agent_123", "type": "ai", "role": "agent", "capabilities": ["ask"], "metadata": {"model": "v2"}},
+			{"id": "customer_456", "type": "human", "name": "Jane", "preferences": {"language": "en", "communication_channels": ["chat"], "theme": "dark"}}
+		],
+		"acts": [
+			{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "What's your email?",
+				"constraints": [{"type": "required", "message": "Email is required"}, {"type": "min_length", "value": 5, "code": "too_short"}],
+				"required": true, "retry_count": 1, "metadata": {"channel": "chat", "processing_time_ms": 12.5, "trace_id": "abc"}},
+			{"id": "act_2", "timestamp": "2025-01-15T15:30:05.123+01:00", "speaker": "customer_456", "type": "fact", "entity": {"id": "order_789", "type": "order"},
+				"field": "email", "value": "jane@example.com", "confidence": 0.9, "previous_value": null},
+			{"id": "act_3", "timestamp": "2025-01-15T14:30:10Z", "speaker": "agent_123", "type": "confirm", "entity": "order_789", "summary": "Email is jane@example.com", "timeout_ms": 30000},
+			{"id": "act_4", "timestamp": "2025-01-15T14:30:15Z", "speaker": "agent_123", "type": "commit", "entity": "order_789", "action": "update", "rollback_info": {"version": 3}},
+			{"id": "act_5", "timestamp": "2025-01-15T14:30:20Z", "speaker": "agent_123", "type": "error", "code": "notify_failed", "message": "Could not send email", "recoverable": true}
+		],
+		"started_at": "2025-01-15T14:30:00Z",
+		"status": "active",
+		"context": {"session_id": "sess_1", "region": "eu"},
+		"final_state": {"email": "jane@example.com"},
+		"time_budget_ms": 60000,
+		"metadata": {"act_count": 5, "source": "import"}
+	}`)
+
+	var expected Conversation
+	require.NoError(t, json.Unmarshal(data, &expected))
+
+	var document interface{}
+	require.NoError(t, json.Unmarshal(data, &document))
+	conv, err := decodeConversationDocument(document)
+	require.NoError(t, err)
+	assert.Equal(t, expected, conv)
+
+	validated, err := UnmarshalConversation(data, true)
+	require.NoError(t, err)
+	assert.Equal(t, expected, validated)
+
+	empty, err := decodeConversationDocument(map[string]interface{}{"id": "conv_1"})
+	require.NoError(t, err)
+	assert.NotNil(t, empty.Acts)
+	assert.Empty(t, empty.Acts)
+
+	// Decoding errors match the ones json.Unmarshal reports
+	for _, invalid := range []string{
+		`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "unknown"}]}`,
+		`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "ask", "timestamp": 5}]}`,
+		`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "ask", "timestamp": "15/01/2025"}]}`,
+		`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "ask", "retry_count": 1.5}]}`,
+		`{"id": 5, "participants": [], "acts": []}`,
+	} {
+		require.Error(t, json.Unmarshal([]byte(invalid), &expected), invalid)
+		_, err := UnmarshalConversation([]byte(invalid), true)
+		assert.Error(t, err, invalid)
 	}
+
+	_, err = UnmarshalConversation([]byte(`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "unknown"}]}`), true)
+	assert.ErrorContains(t, err, "failed to unmarshal act at index 0: unknown act type: unknown")
+
+	var validationErr ValidationError
+	_, err = UnmarshalConversation([]byte(`{"id": "conv_1", "participants": [], "acts": [{"id": "act_1", "type": "ask", "timestamp": 5}]}`), true)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "timestamp", validationErr.Field)
 }
 
-func BenchmarkIsValidActID(b *testing.B) {
-	id := GenerateActID()
-	b.ResetTimer()
-	
-	for i := 0; i < b.N; i++ {
-		IsValidActID(id)
+func TestCompareActs(t *testing.T) {
+	batch := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(id string, timestamp time.Time) Ask {
+		ask := NewAsk("agent_123", "email", "What's your email?")
+		ask.ID = id
+		ask.Timestamp = timestamp
+		return ask
+	}
+	early := at("act_zzz", batch.Add(-time.Second))
+	tiedA := at("act_aaa", batch)
+	tiedB := at("act_bbb", batch)
+	late := at("act_000", batch.Add(time.Second))
+
+	assert.Negative(t, CompareActs(early, tiedA), "timestamp decides first")
+	assert.Negative(t, CompareActs(tiedA, tiedB), "ties are broken by act ID")
+	assert.Positive(t, CompareActs(tiedB, tiedA))
+	assert.Zero(t, CompareActs(tiedA, tiedA))
+	assert.Negative(t, CompareActs(nil, tiedA))
+
+	// Every input order sorts to the same sequence
+	expected := []string{"act_zzz", "act_aaa", "act_bbb", "act_000"}
+	for _, acts := range [][]ConversationAct{
+		{late, tiedB, tiedA, early},
+		{tiedB, early, late, tiedA},
+		{tiedA, tiedB, early, late},
+	} {
+		conv := Conversation{Acts: acts}
+		conv.SortActs()
+		var ids []string
+		for _, act := range conv.Acts {
+			ids = append(ids, act.GetAct().ID)
+		}
+		assert.Equal(t, expected, ids)
+		assert.True(t, conv.IsChronological())
 	}
 }
 
-func BenchmarkNewAsk(b *testing.B) {
-	for i := 0; i < b.N; i++ {
+func TestConversationValidate(t *testing.T) {
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+		NewParticipant("system_001", ParticipantTypeSystem),
+	})
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
+	fact.Timestamp = ask.Timestamp.Add(time.Second)
+	require.NoError(t, conv.AddAct(ask))
+	require.NoError(t, conv.AddAct(fact))
+	assert.Empty(t, conv.Validate())
+
+	// An invalid nested act is reported with its index
+	invalid := NewFact("customer_456", "order_789", "", "user@example.com")
+	invalid.Timestamp = fact.Timestamp.Add(time.Second)
+	conv.Acts = append(conv.Acts, invalid)
+	errs := conv.Validate()
+	require.Len(t, errs, 1)
+	assert.True(t, strings.HasPrefix(errs[0].Error(), "act 2: "), errs[0].Error())
+	conv.Acts = conv.Acts[:2]
+
+	// Container problems are all reported together
+	orphan := NewError("system_001", "TIMEOUT", "Lookup timed out", true, WithRelatedActID("act_dropped"))
+	orphan.Timestamp = fact.Timestamp.Add(time.Second)
+	conv.Acts = append(conv.Acts, orphan)
+	conv.ID = "session_1"
+	conv.Participants = append(conv.Participants, NewParticipant("agent_123", ParticipantTypeAI))
+
+	var messages []string
+	for _, err := range conv.Validate() {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		"invalid conversation ID format: session_1",
+		"participant 3: duplicate participant ID agent_123",
+		"related_act_id act_dropped does not match any act in the conversation",
+	}, messages)
+
+	assert.NotEmpty(t, Conversation{}.Validate())
+}
+
+// cancelAfterContext reports itself canceled once Err has been called more
+// than a given number of times
+type cancelAfterContext struct {
+	context.Context
+	remaining int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.remaining <= 0 {
+		return context.Canceled
+	}
+	c.remaining--
+	return nil
+}
+
+func TestValidateConversationContext(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	for i := 0; i < 10; i++ {
+		ask := NewAsk("agent_123", fmt.Sprintf("field_%d", i), "Question?")
+		if i == 1 {
+			ask.Field = ""
+		}
+		conv.Acts = append(conv.Acts, ask)
+	}
+
+	assert.Equal(t, conv.Validate(), ValidateConversationContext(context.Background(), conv))
+	assert.Len(t, conv.Validate(), 1)
+
+	// Validation stops at the first act checked after cancellation
+	errs := ValidateConversationContext(&cancelAfterContext{Context: context.Background(), remaining: 3}, conv)
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], ErrMissingField)
+	assert.ErrorIs(t, errs[1], context.Canceled)
+	assert.ErrorContains(t, errs[1], "stopped at act 3 of 10")
+
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = UnmarshalConversationContext(canceled, data, true)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = UnmarshalConversationContext(&cancelAfterContext{Context: context.Background(), remaining: 5}, data, true)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, ErrMissingField)
+}
+
+func newLargeConversationJSON(tb testing.TB, actCount int) []byte {
+	tb.Helper()
+
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}, WithConversationChannel("chat"))
+	for i := 0; i < actCount; i++ {
+		if i%2 == 0 {
+			conv.Acts = append(conv.Acts, NewAsk("agent_123", "email", "What's your email?"))
+		} else {
+			conv.Acts = append(conv.Acts, NewFact("customer_456", "order_789", "email", "user@example.com"))
+		}
+	}
+
+	data, err := json.Marshal(conv)
+	require.NoError(tb, err)
+	return data
+}
+
+func TestDecodeConversationStream(t *testing.T) {
+	data := newLargeConversationJSON(t, 10)
+
+	var expected Conversation
+	require.NoError(t, json.Unmarshal(data, &expected))
+
+	var acts []ConversationAct
+	conv, err := DecodeConversationStream(bytes.NewReader(data), func(act ConversationAct) error {
+		acts = append(acts, act)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, expected.ID, conv.ID)
+	assert.Equal(t, expected.Participants, conv.Participants)
+	assert.Equal(t, *expected.Channel, *conv.Channel)
+	assert.Nil(t, conv.Acts)
+	require.Len(t, acts, 10)
+	for i := range acts {
+		assert.Equal(t, expected.Acts[i].GetAct().ID, acts[i].GetAct().ID)
+		assert.Equal(t, expected.Acts[i].GetType(), acts[i].GetType())
+	}
+
+	// Handler errors stop decoding
+	stop := errors.New("stop")
+	seen := 0
+	_, err = DecodeConversationStream(bytes.NewReader(data), func(act ConversationAct) error {
+		seen++
+		if seen == 3 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 3, seen)
+
+	_, err = DecodeConversationStream(bytes.NewReader([]byte(`[]`)), func(ConversationAct) error { return nil })
+	assert.Error(t, err)
+}
+
+func TestConversationJSONL(t *testing.T) {
+	input := `{"id": "conv_123", "participants": [{"id": "agent_123", "type": "ai"}, {"id": "customer_456", "type": "human"}], "status": "active"}
+{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "What's your email?"}
+{"id": "act_2", "timestamp": "2025-01-15T14:30:05Z", "speaker": "customer_456", "type": "fact", "entity": "customer_456", "field": "email", "value": "jane@example.com", "confidence": 0.8}
+
+{"id": "act_3", "timestamp": "2025-01-15T14:30:10Z", "speaker": "agent_123", "type": "confirm", "entity": "customer_456", "summary": "Email is jane@example.com"}
+{"id": "act_4", "timestamp": "2025-01-15T14:30:15Z", "speaker": "system", "type": "commit", "entity": "customer_456", "action": "update", "status": "success"}
+{"id": "act_5", "timestamp": "2025-01-15T14:30:20Z", "speaker": "system", "type": "error", "code": "notify_failed", "message": "Could not send email", "recoverable": true}`
+
+	conv, err := LoadConversationJSONL(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, "conv_123", conv.ID)
+	assert.Len(t, conv.Participants, 2)
+	assert.Equal(t, ConversationStatusActive, *conv.Status)
+	require.Len(t, conv.Acts, 5)
+	assert.IsType(t, Ask{}, conv.Acts[0])
+	assert.IsType(t, Fact{}, conv.Acts[1])
+	assert.IsType(t, Confirm{}, conv.Acts[2])
+	assert.IsType(t, Commit{}, conv.Acts[3])
+	assert.IsType(t, Error{}, conv.Acts[4])
+	require.NotNil(t, conv.Metadata)
+	assert.Equal(t, 5, *conv.Metadata.ActCount)
+	assert.Equal(t, 1, *conv.Metadata.ErrorCount)
+	assert.Equal(t, 1, *conv.Metadata.CommitCount)
+
+	// Writing and loading again gives back the same conversation
+	var buf bytes.Buffer
+	require.NoError(t, WriteConversationJSONL(&buf, conv))
+	assert.Equal(t, 6, strings.Count(buf.String(), "\n"))
+	reloaded, err := LoadConversationJSONL(&buf)
+	require.NoError(t, err)
+	original, err := json.Marshal(conv)
+	require.NoError(t, err)
+	roundTripped, err := json.Marshal(reloaded)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(original), string(roundTripped))
+
+	_, err = LoadConversationJSONL(strings.NewReader("\n\n"))
+	assert.ErrorContains(t, err, "missing conversation header")
+	_, err = LoadConversationJSONL(strings.NewReader(`{"id": "conv_123", "acts": []}`))
+	assert.ErrorContains(t, err, "line 1")
+	_, err = LoadConversationJSONL(strings.NewReader("{\"id\": \"conv_123\"}\n{\"type\": \"bogus\"}\n"))
+	assert.ErrorContains(t, err, "line 2: unknown act type")
+}
+
+func TestActFraming(t *testing.T) {
+	acts := []ConversationAct{
+		NewAsk("agent_123", "address", "What's your address?"),
+		NewFact("customer_456", "customer_456", "address", "1 Main St\nApt 2\nSpringfield"),
+		NewError("system", "GEOCODE_FAILED", "Could not geocode\naddress", true),
+	}
+
+	var buf bytes.Buffer
+	writer := NewFrameWriter(&buf)
+	for _, act := range acts {
+		require.NoError(t, writer.WriteAct(act))
+	}
+
+	// Values containing newlines survive intact
+	reader := NewFrameReader(bytes.NewReader(buf.Bytes()))
+	for _, want := range acts {
+		got, err := reader.ReadAct()
+		require.NoError(t, err)
+		assert.Equal(t, want.GetAct().ID, got.GetAct().ID)
+		assert.Equal(t, want.GetType(), got.GetType())
+		if fact, ok := want.(Fact); ok {
+			assert.Equal(t, fact.Value, got.(Fact).Value)
+		}
+	}
+	_, err := reader.ReadAct()
+	assert.Equal(t, io.EOF, err)
+	_, err = reader.ReadAct()
+	assert.Equal(t, io.EOF, err)
+
+	// A stream cut off mid-frame is not a clean end
+	truncated := NewFrameReader(bytes.NewReader(buf.Bytes()[:buf.Len()-3]))
+	for i := 0; i < 2; i++ {
+		_, err = truncated.ReadAct()
+		require.NoError(t, err)
+	}
+	_, err = truncated.ReadAct()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	_, err = NewFrameReader(bytes.NewReader([]byte{0, 0})).ReadAct()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// An absurd length is rejected without reading the payload
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, 0xFFFFFFF0)
+	oversized := NewFrameReader(bytes.NewReader(header))
+	_, err = oversized.ReadAct()
+	assert.ErrorIs(t, err, ErrFrameTooLarge)
+	_, err = oversized.ReadAct()
+	assert.ErrorIs(t, err, ErrFrameTooLarge, "the reader stays failed")
+
+	// The limit is configurable on both ends
+	_, err = NewFrameReader(bytes.NewReader(buf.Bytes()), WithMaxFrameSize(16)).ReadAct()
+	assert.ErrorIs(t, err, ErrFrameTooLarge)
+	var small bytes.Buffer
+	assert.ErrorIs(t, NewFrameWriter(&small, WithMaxFrameSize(16)).WriteAct(acts[0]), ErrFrameTooLarge)
+	assert.Zero(t, small.Len())
+
+	// A frame that is not an act is reported, and reading continues after it
+	var mixed bytes.Buffer
+	binary.BigEndian.PutUint32(header, 2)
+	mixed.Write(header)
+	mixed.WriteString("{}")
+	require.NoError(t, NewFrameWriter(&mixed).WriteAct(acts[0]))
+	reader = NewFrameReader(&mixed)
+	_, err = reader.ReadAct()
+	assert.ErrorContains(t, err, "unknown act type")
+	act, err := reader.ReadAct()
+	require.NoError(t, err)
+	assert.Equal(t, acts[0].GetAct().ID, act.GetAct().ID)
+}
+
+// ============================================================================
+// YAML Marshaling Tests
+// ============================================================================
+
+const conversationYAML = `
+id: conv_yaml_123
+status: active
+participants:
+  - id: agent_123
+    type: ai
+    role: agent
+  - id: customer_456
+    type: human
+acts:
+  - id: act_ask_1
+    timestamp: "2025-01-15T14:30:00Z"
+    speaker: agent_123
+    type: ask
+    field: email
+    prompt: What's your email?
+    metadata:
+      channel: voice
+      trace_id: abc-123
+  - id: act_fact_1
+    timestamp: "2025-01-15T14:30:05Z"
+    speaker: customer_456
+    type: fact
+    entity: order_789
+    field: email
+    value: user@example.com
+    operation: set
+  - id: act_commit_1
+    timestamp: "2025-01-15T14:30:10Z"
+    speaker: system_001
+    type: commit
+    entity: order_789
+    action: update
+    status: success
+  - id: act_error_1
+    timestamp: "2025-01-15T14:30:15Z"
+    speaker: system_001
+    type: error
+    code: TIMEOUT
+    message: CRM timed out
+    recoverable: true
+    details:
+      attempts: 2
+`
+
+func TestActYAMLRoundTrip(t *testing.T) {
+	ask := NewAsk("agent_123", "email", "What's your email?",
+		WithRequired(true))
+	ask.Metadata = &ActMetadata{
+		AdditionalProperties: map[string]interface{}{"trace_id": "abc-123"},
+	}
+
+	yamlData, err := MarshalActYAML(ask)
+	require.NoError(t, err)
+	assert.Contains(t, string(yamlData), "trace_id: abc-123")
+
+	act, err := UnmarshalActYAML(yamlData)
+	require.NoError(t, err)
+
+	roundTripped, ok := act.(Ask)
+	require.True(t, ok)
+	assert.Equal(t, ask.ID, roundTripped.ID)
+	assert.True(t, ask.Timestamp.Equal(roundTripped.Timestamp))
+	assert.Equal(t, ask.Field, roundTripped.Field)
+	assert.Equal(t, *ask.Required, *roundTripped.Required)
+	require.NotNil(t, roundTripped.Metadata)
+	assert.Equal(t, "abc-123", roundTripped.Metadata.AdditionalProperties["trace_id"])
+
+	_, err = UnmarshalActYAML([]byte("type: [unterminated"))
+	assert.Error(t, err)
+}
+
+func TestConversationYAMLRoundTrip(t *testing.T) {
+	conv, err := UnmarshalConversationYAML([]byte(conversationYAML))
+	require.NoError(t, err)
+
+	assert.Equal(t, "conv_yaml_123", conv.ID)
+	require.Len(t, conv.Participants, 2)
+	require.Len(t, conv.Acts, 4)
+
+	// Act polymorphism survives YAML decoding
+	assert.IsType(t, Ask{}, conv.Acts[0])
+	assert.IsType(t, Fact{}, conv.Acts[1])
+	assert.IsType(t, Commit{}, conv.Acts[2])
+	assert.IsType(t, Error{}, conv.Acts[3])
+
+	// Additional metadata properties survive YAML decoding
+	ask := conv.Acts[0].(Ask)
+	require.NotNil(t, ask.Metadata)
+	assert.Equal(t, "voice", *ask.Metadata.Channel)
+	assert.Equal(t, "abc-123", ask.Metadata.AdditionalProperties["trace_id"])
+
+	// Marshal back to YAML and decode again
+	yamlData, err := MarshalConversationYAML(conv)
+	require.NoError(t, err)
+
+	again, err := UnmarshalConversationYAML(yamlData)
+	require.NoError(t, err)
+	assert.Equal(t, conv.ID, again.ID)
+	require.Len(t, again.Acts, len(conv.Acts))
+	for i, act := range conv.Acts {
+		assert.Equal(t, act.GetType(), again.Acts[i].GetType())
+		assert.Equal(t, act.GetAct().ID, again.Acts[i].GetAct().ID)
+		assert.True(t, act.GetAct().Timestamp.Equal(again.Acts[i].GetAct().Timestamp))
+	}
+	assert.Equal(t, "abc-123", again.Acts[0].(Ask).Metadata.AdditionalProperties["trace_id"])
+	assert.Equal(t, "user@example.com", again.Acts[1].(Fact).Value)
+	assert.Equal(t, 2.0, again.Acts[3].(Error).Details["attempts"])
+}
+
+func TestActMsgpackRoundTrip(t *testing.T) {
+	fact := NewFact("customer_456", NewEntity("order_789", "order"), "items",
+		[]interface{}{"pizza", map[string]interface{}{"size": "large", "quantity": 2}},
+		WithOperation(FieldOperationAppend))
+	fact.Metadata = &ActMetadata{
+		AdditionalProperties: map[string]interface{}{"trace_id": "abc-123"},
+	}
+
+	data, err := MarshalActMsgpack(fact)
+	require.NoError(t, err)
+
+	act, err := UnmarshalActMsgpack(data)
+	require.NoError(t, err)
+
+	// Free-form values come back in the same form as from JSON
+	roundTripped, ok := act.(Fact)
+	require.True(t, ok)
+	assert.Equal(t, fact.ID, roundTripped.ID)
+	assert.True(t, fact.Timestamp.Equal(roundTripped.Timestamp))
+	assert.Equal(t, []interface{}{"pizza", map[string]interface{}{"size": "large", "quantity": 2.0}}, roundTripped.Value)
+	entity, err := roundTripped.EntityRef()
+	require.NoError(t, err)
+	assert.Equal(t, NewEntity("order_789", "order"), entity)
+	assert.Equal(t, "abc-123", roundTripped.Metadata.AdditionalProperties["trace_id"])
+
+	_, err = UnmarshalActMsgpack([]byte{0xc1})
+	assert.Error(t, err)
+}
+
+func TestConversationMsgpackRoundTrip(t *testing.T) {
+	conv, err := UnmarshalConversationYAML([]byte(conversationYAML))
+	require.NoError(t, err)
+
+	data, err := MarshalConversationMsgpack(conv)
+	require.NoError(t, err)
+
+	again, err := UnmarshalConversationMsgpack(data)
+	require.NoError(t, err)
+	assert.Equal(t, conv, again)
+
+	// Encoding is deterministic and smaller than JSON
+	repeat, err := MarshalConversationMsgpack(again)
+	require.NoError(t, err)
+	assert.Equal(t, data, repeat)
+
+	var large Conversation
+	require.NoError(t, json.Unmarshal(newLargeConversationJSON(t, 100), &large))
+	jsonData, err := json.Marshal(large)
+	require.NoError(t, err)
+	msgpackData, err := MarshalConversationMsgpack(large)
+	require.NoError(t, err)
+	assert.Less(t, len(msgpackData), len(jsonData))
+}
+
+// ============================================================================
+// Benchmark Tests
+// ============================================================================
+
+func BenchmarkGenerateActID(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		GenerateActID()
+	}
+}
+
+func BenchmarkGenerateConversationID(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		GenerateConversationID()
+	}
+}
+
+func BenchmarkIsValidActID(b *testing.B) {
+	id := GenerateActID()
+	b.ResetTimer()
+	
+	for i := 0; i < b.N; i++ {
+		IsValidActID(id)
+	}
+}
+
+func BenchmarkNewAsk(b *testing.B) {
+	for i := 0; i < b.N; i++ {
 		NewAsk("agent_123", "email", "What's your email?")
 	}
 }
@@ -1066,7 +4090,7 @@ func BenchmarkActValidation(b *testing.B) {
 }
 
 func BenchmarkActJSONMarshal(b *testing.B) {
-	ask := NewAsk("agent_123", "email", "What's your email?", WithConfidence(0.95))
+	ask := NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](WithConfidence(0.95)))
 	b.ResetTimer()
 	
 	for i := 0; i < b.N; i++ {
@@ -1101,104 +4125,1069 @@ func BenchmarkConversationAddAct(b *testing.B) {
 // Integration Tests
 // ============================================================================
 
-func TestFullConversationWorkflow(t *testing.T) {
-	// Create participants
-	agent := NewParticipant("agent_123", ParticipantTypeAI,
-		WithRole("customer_service"),
-		WithName("Support Agent"),
-		WithCapabilities([]string{"order_lookup", "payment_processing"}))
+func TestFullConversationWorkflow(t *testing.T) {
+	// Create participants
+	agent := NewParticipant("agent_123", ParticipantTypeAI,
+		WithRole("customer_service"),
+		WithName("Support Agent"),
+		WithCapabilities([]string{"order_lookup", "payment_processing"}))
+
+	customer := NewParticipant("customer_456", ParticipantTypeHuman,
+		WithRole("customer"),
+		WithName("John Doe"),
+		WithEmail("john@example.com"))
+
+	participants := []Participant{agent, customer}
+
+	// Create conversation
+	conv := NewConversation(participants,
+		WithConversationChannel("voice"),
+		WithConversationSchema("customer_service_v1"))
+
+	assert.Equal(t, ConversationStatusActive, *conv.Status)
+	assert.Equal(t, 0, len(conv.Acts))
+
+	// Agent asks for customer information
+	askEmail := NewAsk("agent_123", "email", "What's your email address?",
+		WithRequired(true),
+		WithExpectedType(ExpectedTypeEmail),
+		WithConstraints([]Constraint{EmailFormatConstraint()}))
+
+	err := conv.AddAct(askEmail)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(conv.Acts))
+
+	// Customer provides email
+	factEmail := NewFact("customer_456", "customer_456", "email", "john@example.com",
+		WithOperation(FieldOperationSet),
+		WithValidationStatus(ValidationStatusValid))
+
+	err = conv.AddAct(factEmail)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(conv.Acts))
+
+	// Agent confirms the information
+	confirm := NewConfirm("agent_123", "customer_456", "Your email is john@example.com, is that correct?",
+		WithAwaiting(true))
+
+	err = conv.AddAct(confirm)
+	assert.NoError(t, err)
+
+	// Customer confirms
+	confirmResponse := NewFact("customer_456", confirm.ID, "confirmed", true,
+		WithOperation(FieldOperationSet))
+
+	err = conv.AddAct(confirmResponse)
+	assert.NoError(t, err)
+
+	// System commits the information
+	commit := NewCommit("system_001", "customer_456", CommitActionUpdate,
+		WithSystem("customer_management"),
+		WithCommitStatus(CommitStatusSuccess))
+
+	err = conv.AddAct(commit)
+	assert.NoError(t, err)
+
+	// End the conversation
+	conv.EndConversation(ConversationStatusCompleted)
+
+	// Verify final state
+	assert.Equal(t, ConversationStatusCompleted, *conv.Status)
+	assert.Equal(t, 5, len(conv.Acts))
+	assert.NotNil(t, conv.EndedAt)
+	assert.NotNil(t, conv.Metadata)
+	assert.Equal(t, 5, *conv.Metadata.ActCount)
+	assert.Equal(t, 1, *conv.Metadata.CommitCount)
+	assert.Equal(t, 0, *conv.Metadata.ErrorCount)
+
+	// Test querying methods
+	asks := conv.GetActsByType(ActTypeAsk)
+	assert.Equal(t, 1, len(asks))
+
+	facts := conv.GetActsByType(ActTypeFact)
+	assert.Equal(t, 2, len(facts))
+
+	agentActs := conv.GetActsBySpeaker("agent_123")
+	assert.Equal(t, 2, len(agentActs))
+
+	customerActs := conv.GetActsBySpeaker("customer_456")
+	assert.Equal(t, 2, len(customerActs))
+
+	// Test JSON serialization of the complete conversation
+	jsonData, err := json.Marshal(conv)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, jsonData)
+
+	var unmarshaledConv Conversation
+	err = json.Unmarshal(jsonData, &unmarshaledConv)
+	assert.NoError(t, err)
+	assert.Equal(t, conv.ID, unmarshaledConv.ID)
+	assert.Equal(t, len(conv.Acts), len(unmarshaledConv.Acts))
+}
+
+// newWorkflowConversation builds the customer service conversation used by
+// TestFullConversationWorkflow
+func newWorkflowConversation(t *testing.T) Conversation {
+	t.Helper()
+
+	agent := NewParticipant("agent_123", ParticipantTypeAI,
+		WithRole("customer_service"),
+		WithName("Support Agent"),
+		WithCapabilities([]string{"order_lookup", "payment_processing"}))
+
+	customer := NewParticipant("customer_456", ParticipantTypeHuman,
+		WithRole("customer"),
+		WithName("John Doe"),
+		WithEmail("john@example.com"))
+
+	conv := NewConversation([]Participant{agent, customer},
+		WithConversationChannel("voice"),
+		WithConversationSchema("customer_service_v1"))
+
+	confirm := NewConfirm("agent_123", "customer_456", "Your email is john@example.com, is that correct?",
+		WithAwaiting(true))
+
+	acts := []ConversationAct{
+		NewAsk("agent_123", "email", "What's your email address?",
+			WithRequired(true),
+			WithExpectedType(ExpectedTypeEmail),
+			WithConstraints([]Constraint{EmailFormatConstraint()})),
+		NewFact("customer_456", "customer_456", "email", "john@example.com",
+			WithOperation(FieldOperationSet),
+			WithValidationStatus(ValidationStatusValid)),
+		confirm,
+		NewFact("customer_456", confirm.ID, "confirmed", true,
+			WithOperation(FieldOperationSet)),
+		NewCommit("system_001", "customer_456", CommitActionUpdate,
+			WithSystem("customer_management"),
+			WithCommitStatus(CommitStatusSuccess)),
+	}
+	for _, act := range acts {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	conv.EndConversation(ConversationStatusCompleted)
+	return conv
+}
+
+func TestConversationGetActsInTimeRange(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	base := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	at := func(minutes int, field string) Ask {
+		return NewAsk("agent_123", field, "Question?", WithAct[Ask](WithTimestamp(base.Add(time.Duration(minutes)*time.Minute))))
+	}
+	// Deliberately out of order
+	late, start, middle, end, early := at(10, "late"), at(0, "start"), at(2, "middle"), at(5, "end"), at(-1, "early")
+	conv.Acts = []ConversationAct{late, start, middle, end, early}
+
+	rangeEnd := base.Add(5 * time.Minute)
+	assert.Equal(t, []ConversationAct{start, middle, end}, conv.GetActsInTimeRange(base, rangeEnd, true))
+	assert.Equal(t, []ConversationAct{middle}, conv.GetActsInTimeRange(base, rangeEnd, false))
+	assert.Empty(t, conv.GetActsInTimeRange(rangeEnd, base, true))
+	assert.Equal(t, []ConversationAct{start}, conv.GetActsInTimeRange(base, base, true))
+	assert.Empty(t, conv.GetActsInTimeRange(base, base, false))
+}
+
+func TestConversationTimestampIDMismatches(t *testing.T) {
+	generatedAt := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	generator := NewIDGenerator(1, func() time.Time { return generatedAt })
+	at := func(id string, timestamp time.Time) Ask {
+		ask := NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](WithTimestamp(timestamp)))
+		ask.ID = id
+		return ask
+	}
+
+	matching := at(generator.ActID(), generatedAt.Add(500*time.Microsecond))
+	skewed := at(generator.ActID(), generatedAt.Add(2*time.Second))
+	copied := at(generator.ActID(), generatedAt.Add(-time.Hour))
+	handWritten := at("act_1", generatedAt.Add(time.Hour))
+
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	conv.Acts = []ConversationAct{matching, skewed, copied, handWritten}
+
+	assert.Equal(t, []string{skewed.ID, copied.ID}, conv.TimestampIDMismatches(time.Second))
+	assert.Equal(t, []string{copied.ID}, conv.TimestampIDMismatches(time.Minute))
+	assert.Equal(t, []string{skewed.ID, copied.ID}, conv.TimestampIDMismatches(0), "sub-millisecond differences are tolerated")
+}
+
+func TestConversationCommitsByStatus(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("system", ParticipantTypeSystem)})
+	assert.Equal(t, 0.0, conv.CommitSuccessRate(), "no commits")
+
+	statuses := []CommitStatus{CommitStatusSuccess, CommitStatusFailed, CommitStatusSuccess, CommitStatusRetrying, CommitStatusCancelled}
+	for _, status := range statuses {
+		commit := NewCommit("system", "order_789", CommitActionUpdate, WithCommitStatus(status))
+		if status == CommitStatusFailed || status == CommitStatusRetrying {
+			commit.Error = &CommitError{Code: "timeout", Message: "Backend timed out", Recoverable: true}
+		}
+		require.NoError(t, conv.AddAct(commit))
+	}
+	require.NoError(t, conv.AddAct(NewCommit("system", "order_789", CommitActionUpdate)))
+	require.NoError(t, conv.AddAct(NewAsk("system", "email", "What's your email?")))
+
+	successes := conv.GetCommitsByStatus(CommitStatusSuccess)
+	require.Len(t, successes, 2)
+	assert.Equal(t, conv.Acts[0].GetAct().ID, successes[0].ID)
+	assert.Equal(t, conv.Acts[2].GetAct().ID, successes[1].ID)
+	assert.Len(t, conv.GetCommitsByStatus(CommitStatusFailed), 1)
+	assert.Len(t, conv.GetCommitsByStatus(CommitStatusRetrying), 1)
+	assert.Len(t, conv.GetCommitsByStatus(CommitStatusCancelled), 1)
+	assert.Empty(t, conv.GetCommitsByStatus(CommitStatusPending))
+
+	// Six commits, including one without a status, two of them successful
+	assert.InDelta(t, 2.0/6.0, conv.CommitSuccessRate(), 1e-9)
+
+	stats := conv.Stats()
+	assert.Equal(t, map[CommitStatus]int{
+		CommitStatusSuccess:   2,
+		CommitStatusFailed:    1,
+		CommitStatusRetrying:  1,
+		CommitStatusCancelled: 1,
+	}, stats.CommitsByStatus)
+	assert.Equal(t, 2, stats.SuccessfulCommits)
+	assert.Equal(t, conv.CommitSuccessRate(), stats.CommitSuccessRate)
+	assert.Equal(t, 1.0, conv.MetricsSnapshot()["astra_commits_failed_total"])
+}
+
+func TestConversationErrorAnalysis(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("system", ParticipantTypeSystem)})
+	assert.Empty(t, conv.CriticalErrors())
+	assert.False(t, conv.HasUnrecoverableError())
+
+	warning := NewError("system", "SLOW_RESPONSE", "CRM responded slowly", true,
+		WithSeverity(ErrorSeverityWarning), WithCategory(ErrorCategoryIntegration))
+	defaulted := NewError("system", "INVALID_EMAIL", "Email is malformed", true,
+		WithCategory(ErrorCategoryValidation))
+	critical := NewError("system", "DATA_LOSS", "Order draft was lost", true,
+		WithSeverity(ErrorSeverityCritical), WithCategory(ErrorCategorySystem))
+	for _, act := range []ConversationAct{warning, defaulted, critical} {
+		require.NoError(t, conv.AddAct(act))
+	}
+
+	integration := conv.GetErrorsByCategory(ErrorCategoryIntegration)
+	require.Len(t, integration, 1)
+	assert.Equal(t, warning.ID, integration[0].ID)
+	assert.Empty(t, conv.GetErrorsByCategory(ErrorCategoryTimeout))
+
+	// A missing severity defaults to error, which is not critical on its own
+	criticalErrors := conv.CriticalErrors()
+	require.Len(t, criticalErrors, 1)
+	assert.Equal(t, critical.ID, criticalErrors[0].ID)
+	assert.False(t, conv.HasUnrecoverableError(), "critical but recoverable")
+
+	// An unrecoverable error is critical whatever its severity
+	fatal := NewError("system", "CRM_DOWN", "CRM is unavailable", false,
+		WithSeverity(ErrorSeverityWarning), WithCategory(ErrorCategoryIntegration))
+	require.NoError(t, conv.AddAct(fatal))
+	criticalErrors = conv.CriticalErrors()
+	require.Len(t, criticalErrors, 2)
+	assert.Equal(t, fatal.ID, criticalErrors[1].ID)
+	assert.True(t, conv.HasUnrecoverableError())
+	assert.Len(t, conv.GetErrorsByCategory(ErrorCategoryIntegration), 2)
+}
+
+func TestConversationStats(t *testing.T) {
+	conv := newWorkflowConversation(t)
+
+	stats := conv.Stats()
+
+	assert.Equal(t, 5, stats.TotalActs)
+	assert.Equal(t, map[ActType]int{
+		ActTypeAsk:     1,
+		ActTypeFact:    2,
+		ActTypeConfirm: 1,
+		ActTypeCommit:  1,
+	}, stats.ActsByType)
+	assert.Equal(t, map[string]int{
+		"agent_123":    2,
+		"customer_456": 2,
+		"system_001":   1,
+	}, stats.ActsBySpeaker)
+
+	// customer_456 plus the confirm act referenced by the confirmation fact
+	assert.Equal(t, 2, stats.UniqueEntities)
+	assert.Equal(t, 1, stats.CommitCount)
+	assert.Equal(t, 1, stats.SuccessfulCommits)
+	assert.Equal(t, 1.0, stats.CommitSuccessRate)
+	assert.Equal(t, 0, stats.ErrorCount)
+	assert.Empty(t, stats.ErrorsByCategory)
+	assert.Nil(t, stats.AvgConfidence)
+	assert.Nil(t, stats.AvgProcessingTimeMs)
+
+	// Errors and processing times are broken down once present
+	processingTime := 120.0
+	errorAct := NewError("system_001", "TIMEOUT", "CRM timed out", true,
+		WithCategory(ErrorCategoryIntegration))
+	errorAct.Metadata = &ActMetadata{ProcessingTimeMs: &processingTime}
+	require.NoError(t, conv.AddAct(errorAct))
+
+	stats = conv.Stats()
+	assert.Equal(t, 1, stats.ErrorCount)
+	assert.Equal(t, 1, stats.ErrorsByCategory[ErrorCategoryIntegration])
+	require.NotNil(t, stats.AvgProcessingTimeMs)
+	assert.Equal(t, processingTime, *stats.AvgProcessingTimeMs)
+}
+
+func TestConversationProcessingLatency(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	assert.Equal(t, LatencyStats{}, conv.ProcessingLatency())
+
+	// 1 through 20 ms in scrambled order, plus an act that reports nothing
+	for _, ms := range []int{7, 19, 3, 12, 20, 1, 15, 9, 4, 18, 11, 2, 16, 6, 13, 10, 17, 5, 14, 8} {
+		require.NoError(t, conv.AddAct(NewAsk("agent_123", "email", "What's your email?",
+			WithAct[Ask](WithMetadataProperty("processing_time_ms", ms)))))
+	}
+	require.NoError(t, conv.AddAct(NewAsk("agent_123", "phone", "What's your phone number?")))
+
+	assert.Equal(t, LatencyStats{
+		Count:  20,
+		MinMs:  1,
+		MaxMs:  20,
+		MeanMs: 10.5,
+		P50Ms:  10,
+		P95Ms:  19,
+	}, conv.ProcessingLatency())
+
+	// A single sample is every percentile
+	single := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	require.NoError(t, single.AddAct(NewAsk("agent_123", "email", "What's your email?",
+		WithAct[Ask](WithMetadataProperty("processing_time_ms", 42.5)))))
+	assert.Equal(t, LatencyStats{Count: 1, MinMs: 42.5, MaxMs: 42.5, MeanMs: 42.5, P50Ms: 42.5, P95Ms: 42.5}, single.ProcessingLatency())
+}
+
+func TestConversationTranscript(t *testing.T) {
+	start := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	fact := NewFact("customer_456", NewEntity("order_789", "order"), "email", "user@example.com")
+	confidence := 0.92
+	fact.Confidence = &confidence
+	errorAct := NewError("system_001", "VALIDATION_ERROR", "Invalid email format", true)
+	for i, act := range []*Act{&ask.Act, &fact.Act, &errorAct.Act} {
+		act.Timestamp = start.Add(time.Duration(i) * time.Second)
+	}
+	conv.Acts = []ConversationAct{ask, fact, errorAct}
+
+	assert.Equal(t, "[14:30:00] agent_123 (ask): What's your email?\n"+
+		"[14:30:01] customer_456 (fact): order_789.email = user@example.com\n"+
+		"[14:30:02] system_001 (error): VALIDATION_ERROR - Invalid email format\n",
+		conv.Transcript())
+
+	// Timestamps follow the conversation's time zone, and confidence can be shown
+	conv.Context = &ConversationContext{
+		AdditionalProperties: map[string]interface{}{"timezone": "America/New_York"},
+	}
+	transcript := conv.TranscriptWithOptions(TranscriptOptions{ShowConfidence: true})
+	assert.Contains(t, transcript, "[09:30:01] customer_456 (fact): order_789.email = user@example.com (confidence 0.92)\n")
+}
+
+func TestConversationFactsCSV(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("customer_456", ParticipantTypeHuman)})
+	conv.ID = "conv_csv"
+	at := time.Date(2025, time.January, 15, 14, 30, 0, 0, time.UTC)
+
+	email := NewFact("customer_456", "customer_456", "email", "jane@example.com", WithOperation(FieldOperationSet))
+	email.Timestamp = at
+	valid := ValidationStatusValid
+	email.ValidationStatus = &valid
+	quantity := NewFact("customer_456", NewEntity("order_789", "order"), "quantity", 2)
+	quantity.Timestamp = at.Add(time.Second)
+	address := NewFact("customer_456", "order_789", "address",
+		map[string]interface{}{"city": "Austin", "lines": []string{"12 Main St", "Apt 4"}})
+	address.Timestamp = at.Add(2 * time.Second)
+	conv.Acts = []ConversationAct{NewAsk("customer_456", "email", "Email?"), email, quantity, address}
+
+	var buf bytes.Buffer
+	require.NoError(t, conv.FactsCSV(&buf))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"conversation_id", "act_id", "timestamp", "speaker", "entity_id", "field", "operation", "value", "validation_status"},
+		{"conv_csv", email.ID, "2025-01-15T14:30:00Z", "customer_456", "customer_456", "email", "set", "jane@example.com", "valid"},
+		{"conv_csv", quantity.ID, "2025-01-15T14:30:01Z", "customer_456", "order_789", "quantity", "", "2", ""},
+		{"conv_csv", address.ID, "2025-01-15T14:30:02Z", "customer_456", "order_789", "address", "",
+			`{"city":"Austin","lines":["12 Main St","Apt 4"]}`, ""},
+	}, rows)
+
+	conv.Acts = []ConversationAct{NewFact("customer_456", 42, "email", "jane@example.com")}
+	assert.Error(t, conv.FactsCSV(&buf))
+}
+
+func TestConversationMarkdown(t *testing.T) {
+	start := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI, WithRole("agent"), WithName("Support Agent")),
+		NewParticipant("customer_456", ParticipantTypeHuman, WithRole("customer")),
+	}, WithConversationChannel("voice"))
+	conv.ID = "conv_example"
+	conv.StartedAt = &start
+
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
+	commit := NewCommit("system_001", "order_789", CommitActionUpdate,
+		WithSystem("order_management"), WithCommitStatus(CommitStatusSuccess))
+	errorAct := NewError("system_001", "SLOW_RESPONSE", "CRM responded slowly", true,
+		WithSeverity(ErrorSeverityWarning))
+	for i, act := range []*Act{&ask.Act, &fact.Act, &commit.Act, &errorAct.Act} {
+		act.Timestamp = start.Add(time.Duration(i) * time.Second)
+	}
+	conv.Acts = []ConversationAct{ask, fact, commit, errorAct}
+
+	expected := `# Conversation conv_example
+
+- **Status:** active
+- **Channel:** voice
+- **Started:** 2025-01-15T14:30:00Z
+- **Acts:** 4
+
+## Participants
+
+| ID | Type | Role | Name |
+| --- | --- | --- | --- |
+| agent_123 | ai | agent | Support Agent |
+| customer_456 | human | customer |  |
+
+## Acts
+
+- ` + "`2025-01-15T14:30:00Z`" + ` **agent_123** ❓ Ask: What's your email?
+- ` + "`2025-01-15T14:30:01Z`" + ` **customer_456** 📝 Fact: order_789.email = user@example.com
+- ` + "`2025-01-15T14:30:02Z`" + ` **system_001** 💾 Commit: update order_789 in order_management [success]
+- ` + "`2025-01-15T14:30:03Z`" + ` **system_001** ⚠️ Error
+
+  > [!WARNING]
+  > **SLOW_RESPONSE** - CRM responded slowly
+
+## Final State
+
+### order_789
+
+| Field | Value |
+| --- | --- |
+| email | user@example.com |
+`
+	assert.Equal(t, expected, conv.Markdown())
+	assert.Equal(t, conv.Markdown(), conv.Markdown())
+}
+
+func TestConversationMetricsSnapshot(t *testing.T) {
+	conv := newWorkflowConversation(t)
+	require.NoError(t, conv.AddAct(NewError("system_001", "TIMEOUT", "CRM timed out", true,
+		WithCategory(ErrorCategoryTimeout))))
+
+	snapshot := conv.MetricsSnapshot()
+
+	assert.Equal(t, map[string]float64{
+		"astra_acts_total":            6,
+		"astra_acts_ask_total":        1,
+		"astra_acts_fact_total":       2,
+		"astra_acts_confirm_total":    1,
+		"astra_acts_commit_total":     1,
+		"astra_acts_error_total":      1,
+		"astra_errors_total":          1,
+		"astra_errors_timeout_total":  1,
+		"astra_commits_total":         1,
+		"astra_commits_success_total": 1,
+		"astra_commit_success_rate":   1,
+		"astra_unique_entities":       2,
+	}, snapshot)
+}
+
+func TestConversationRedact(t *testing.T) {
+	conv := newWorkflowConversation(t)
+	ipAddress := "203.0.113.7"
+	conv.Context = &ConversationContext{IPAddress: &ipAddress}
+	phone := "+15551234567"
+	conv.Participants[1].Phone = &phone
+	originalText := "my card ends in 4242"
+	cardFact := NewFact("customer_456", NewEntity("card_1", "payment_card"), "last4", "4242")
+	cardFact.Metadata = &ActMetadata{OriginalText: &originalText}
+	require.NoError(t, conv.AddAct(cardFact))
+
+	redacted := conv.Redact(RedactOptions{
+		FactFields:  []string{"email"},
+		EntityTypes: []string{"payment_card"},
+	})
+
+	assert.Equal(t, DefaultRedactionMask, *redacted.Participants[1].Email)
+	assert.Equal(t, DefaultRedactionMask, *redacted.Participants[1].Phone)
+	assert.Equal(t, "John Doe", *redacted.Participants[1].Name)
+	assert.Equal(t, DefaultRedactionMask, *redacted.Context.IPAddress)
+
+	require.Len(t, redacted.Acts, len(conv.Acts))
+	for i := range conv.Acts {
+		assert.Equal(t, conv.Acts[i].GetAct().ID, redacted.Acts[i].GetAct().ID)
+	}
+	assert.Equal(t, DefaultRedactionMask, redacted.Acts[1].(Fact).Value)
+	assert.Equal(t, true, redacted.Acts[3].(Fact).Value)
+	redactedCard := redacted.Acts[5].(Fact)
+	assert.Equal(t, DefaultRedactionMask, redactedCard.Value)
+	assert.Equal(t, DefaultRedactionMask, *redactedCard.Metadata.OriginalText)
+
+	// The original conversation is untouched
+	assert.Equal(t, "john@example.com", *conv.Participants[1].Email)
+	assert.Equal(t, ipAddress, *conv.Context.IPAddress)
+	assert.Equal(t, "john@example.com", conv.Acts[1].(Fact).Value)
+	assert.Equal(t, originalText, *conv.Acts[5].(Fact).Metadata.OriginalText)
+
+	// Hashing keeps equal values correlated without revealing them
+	hashed := conv.Redact(RedactOptions{FactFields: []string{"email"}, Hash: true})
+	hashedEmail := hashed.Acts[1].(Fact).Value
+	assert.NotEqual(t, "john@example.com", hashedEmail)
+	assert.Equal(t, *hashed.Participants[1].Email, hashedEmail)
+}
+
+func TestConversationPseudonymize(t *testing.T) {
+	key := []byte("research-key")
+	customer := NewParticipant("customer_456", ParticipantTypeHuman, WithName("Jane Doe"), WithEmail("Jane@Example.com"), WithPhone("+1 (555) 123-4567"))
+	agent := NewParticipant("agent_123", ParticipantTypeAI)
+
+	first := NewConversation([]Participant{agent, customer})
+	first.AddAct(NewAsk("agent_123", "email", "What's your email?"))
+	first.AddAct(NewFact("customer_456", NewEntity("customer_456", "customer"), "email", "jane@example.com"))
+	first.FinalState = map[string]interface{}{"customer_456": map[string]interface{}{"email": "jane@example.com"}}
+
+	second := NewConversation([]Participant{NewParticipant("customer_456", ParticipantTypeHuman, WithEmail("jane@example.com"))})
+	second.AddAct(NewFact("customer_456", "order_789", "status", "shipped"))
+
+	p1 := first.Pseudonymize(key)
+	p2 := second.Pseudonymize(key)
+
+	token := p1.Participants[1].ID
+	assert.NotEqual(t, "customer_456", token)
+	assert.Equal(t, token, p2.Participants[0].ID, "the same participant maps to the same token across conversations")
+	assert.Equal(t, *p1.Participants[1].Email, *p2.Participants[0].Email, "emails are compared case-insensitively")
+	assert.True(t, IsValidEmail(*p1.Participants[1].Email))
+	assert.NotContains(t, *p1.Participants[1].Name, "Jane")
+	assert.NotContains(t, *p1.Participants[1].Phone, "555")
+
+	// Speakers, entity references, and final state follow the participant token
+	assert.Equal(t, p1.Participants[0].ID, p1.Acts[0].GetAct().Speaker)
+	fact := p1.Acts[1].(Fact)
+	assert.Equal(t, token, fact.Speaker)
+	entityID, err := GetEntityID(fact.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, token, entityID)
+	assert.Contains(t, p1.FinalState, token)
+	assert.NotContains(t, p1.FinalState, "customer_456")
+
+	// Entities that are not participants keep their IDs
+	entityID, err = GetEntityID(p2.Acts[0].(Fact).Entity)
+	require.NoError(t, err)
+	assert.Equal(t, "order_789", entityID)
+
+	// A different key yields different tokens, and the original is untouched
+	assert.NotEqual(t, token, first.Pseudonymize([]byte("other-key")).Participants[1].ID)
+	assert.Equal(t, "customer_456", first.Participants[1].ID)
+	assert.Equal(t, "Jane@Example.com", *first.Participants[1].Email)
+	assert.Equal(t, "customer_456", first.Acts[1].GetAct().Speaker)
+	assert.Contains(t, first.FinalState, "customer_456")
+}
+
+func TestActSignature(t *testing.T) {
+	priv := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+	pub := priv.Public().(ed25519.PublicKey)
+
+	act := NewAsk("agent_123", "email", "What's your email?",
+		WithAct[Ask](WithConfidence(0.9), WithSource(SourceAI), WithChannel("voice"),
+			WithMetadata(ActMetadata{AdditionalProperties: map[string]interface{}{"turn": 3}}))).Act
+	signature, err := act.Sign(priv)
+	require.NoError(t, err)
+	assert.Equal(t, SignatureAlgorithmEd25519, signature.Algorithm)
+	act.Signature = &signature
+	require.NoError(t, VerifyActSignature(act, pub))
+	require.NoError(t, validateBaseAct(act))
+
+	// Mutating any signed field invalidates the signature
+	mutations := map[string]func(a *Act){
+		"id":         func(a *Act) { a.ID = GenerateActID() },
+		"timestamp":  func(a *Act) { a.Timestamp = a.Timestamp.Add(time.Nanosecond) },
+		"speaker":    func(a *Act) { a.Speaker = "agent_999" },
+		"type":       func(a *Act) { a.Type = ActTypeFact },
+		"confidence": func(a *Act) { *a.Confidence = 0.91 },
+		"source":     func(a *Act) { *a.Source = SourceHuman },
+		"channel":    func(a *Act) { *a.Metadata.Channel = "text" },
+		"metadata":   func(a *Act) { a.Metadata.AdditionalProperties["turn"] = 4 },
+		"unset":      func(a *Act) { a.Confidence = nil },
+	}
+	for name, mutate := range mutations {
+		t.Run(name, func(t *testing.T) {
+			mutated := cloneBaseAct(act)
+			mutate(&mutated)
+			assert.ErrorIs(t, VerifyActSignature(mutated, pub), ErrInvalidSignature)
+			assert.NoError(t, VerifyActSignature(act, pub), "original act must be unaffected")
+		})
+	}
+
+	// The canonical form does not depend on the timestamp's location
+	moved := cloneBaseAct(act)
+	moved.Timestamp = moved.Timestamp.In(time.FixedZone("UTC+2", 2*60*60))
+	assert.NoError(t, VerifyActSignature(moved, pub))
+
+	_, otherKey, err := ed25519.GenerateKey(bytes.NewReader(bytes.Repeat([]byte{9}, ed25519.SeedSize)))
+	require.NoError(t, err)
+	assert.ErrorIs(t, VerifyActSignature(act, otherKey.Public().(ed25519.PublicKey)), ErrInvalidSignature)
+
+	tampered := cloneBaseAct(act)
+	tampered.Signature.Value = "not base64!"
+	assert.ErrorIs(t, VerifyActSignature(tampered, pub), ErrInvalidSignature)
+	tampered.Signature.Algorithm = "rsa"
+	assert.ErrorIs(t, VerifyActSignature(tampered, pub), ErrInvalidSignature)
+	assert.Error(t, validateBaseAct(tampered))
+
+	unsigned := cloneBaseAct(act)
+	unsigned.Signature = nil
+	assert.ErrorIs(t, VerifyActSignature(unsigned, pub), ErrMissingField)
+
+	_, err = act.Sign(priv[:10])
+	assert.Error(t, err)
+
+	// SignAct covers specialized properties and survives a JSON round trip
+	fact := NewFact("customer_456", NewEntity("order_789", "order"), "total", 42.5, WithPreviousValue(40.0))
+	factSignature, err := SignAct(fact, priv)
+	require.NoError(t, err)
+	fact.Signature = &factSignature
 
-	customer := NewParticipant("customer_456", ParticipantTypeHuman,
-		WithRole("customer"),
-		WithName("John Doe"),
-		WithEmail("john@example.com"))
+	data, err := MarshalAct(fact)
+	require.NoError(t, err)
+	require.NoError(t, ValidateJSON(data, "fact"))
+	decoded, err := UnmarshalAct(data)
+	require.NoError(t, err)
+	assert.NoError(t, VerifyAct(decoded, pub))
+
+	factMutations := map[string]func(f *Fact){
+		"entity":         func(f *Fact) { f.Entity = NewEntity("order_790", "order") },
+		"field":          func(f *Fact) { f.Field = "subtotal" },
+		"value":          func(f *Fact) { f.Value = 42.0 },
+		"operation":      func(f *Fact) { operation := FieldOperationAppend; f.Operation = &operation },
+		"previous_value": func(f *Fact) { f.PreviousValue = nil },
+	}
+	for name, mutate := range factMutations {
+		t.Run("fact_"+name, func(t *testing.T) {
+			mutated := fact
+			mutate(&mutated)
+			assert.ErrorIs(t, VerifyAct(mutated, pub), ErrInvalidSignature)
+		})
+	}
+}
 
-	participants := []Participant{agent, customer}
+func TestMergeConversations(t *testing.T) {
+	base := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+	at := func(seconds int) ActOption {
+		return func(a *Act) {
+			a.Timestamp = base.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+	agent := NewParticipant("agent_123", ParticipantTypeAI)
+	customer := NewParticipant("customer_456", ParticipantTypeHuman, WithName("Jane Doe"))
+	specialist := NewParticipant("agent_789", ParticipantTypeHuman, WithRole("specialist"))
 
-	// Create conversation
-	conv := NewConversation(participants,
-		WithConversationChannel("voice"),
-		WithConversationSchema("customer_service_v1"))
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	ask.Act = CreateBaseAct("agent_123", ActTypeAsk, at(0))
+	fact := NewFact("customer_456", "customer_456", "email", "jane@example.com")
+	fact.Act = CreateBaseAct("customer_456", ActTypeFact, at(10))
+	transfer := NewAsk("agent_789", "issue", "How can I help?")
+	transfer.Act = CreateBaseAct("agent_789", ActTypeAsk, at(5))
+	confirm := NewConfirm("agent_789", "customer_456", "Is jane@example.com right?")
+	confirm.Act = CreateBaseAct("agent_789", ActTypeConfirm, at(20))
+
+	// The first log covers the start of the call, the second overlaps it after the transfer
+	first := NewConversation([]Participant{agent, customer})
+	first.StartedAt = &base
+	firstEnd := base.Add(12 * time.Second)
+	first.EndedAt = &firstEnd
+	require.NoError(t, first.AddAct(ask))
+	require.NoError(t, first.AddAct(fact))
+
+	second := NewConversation([]Participant{customer, specialist}, WithConversationChannel("voice"))
+	secondStart := base.Add(5 * time.Second)
+	second.StartedAt = &secondStart
+	secondEnd := base.Add(30 * time.Second)
+	second.EndedAt = &secondEnd
+	require.NoError(t, second.AddAct(transfer))
+	require.NoError(t, second.AddAct(fact))
+	require.NoError(t, second.AddAct(confirm))
+
+	// Overlapping act IDs are an error unless deduplicated
+	_, err := MergeConversations(first, second)
+	assert.ErrorContains(t, err, fact.ID)
+
+	merged, err := MergeConversations(first, second, DedupeActs(true))
+	require.NoError(t, err)
 
-	assert.Equal(t, ConversationStatusActive, *conv.Status)
-	assert.Equal(t, 0, len(conv.Acts))
+	assert.Equal(t, first.ID, merged.ID)
+	require.Len(t, merged.Participants, 3)
+	assert.Equal(t, "agent_789", merged.Participants[2].ID)
 
-	// Agent asks for customer information
-	askEmail := NewAsk("agent_123", "email", "What's your email address?",
-		WithRequired(true),
-		WithExpectedType(ExpectedTypeEmail),
-		WithConstraints([]Constraint{EmailFormatConstraint()}))
+	require.Len(t, merged.Acts, 4)
+	var ids []string
+	for _, act := range merged.Acts {
+		ids = append(ids, act.GetAct().ID)
+	}
+	assert.Equal(t, []string{ask.ID, transfer.ID, fact.ID, confirm.ID}, ids)
+
+	assert.Equal(t, base, *merged.StartedAt)
+	assert.Equal(t, secondEnd, *merged.EndedAt)
+	assert.Equal(t, "voice", *merged.Channel)
+	assert.Equal(t, 4, *merged.Metadata.ActCount)
+	assert.Equal(t, int64(30000), *merged.Metadata.TotalDurationMs)
+
+	// Inputs are left untouched
+	assert.Len(t, first.Acts, 2)
+	assert.Len(t, second.Participants, 2)
+
+	// A participant defined differently in each conversation is a conflict
+	renamed := NewParticipant("customer_456", ParticipantTypeHuman, WithName("J. Doe"))
+	third := NewConversation([]Participant{renamed})
+	_, err = MergeConversations(first, third)
+	assert.ErrorContains(t, err, "conflicting definitions for participant customer_456")
+}
 
-	err := conv.AddAct(askEmail)
-	assert.NoError(t, err)
-	assert.Equal(t, 1, len(conv.Acts))
+func TestEntityEqualityAndMerge(t *testing.T) {
+	fromString, err := NormalizeEntityRef("order_789")
+	require.NoError(t, err)
+	order := NewEntity("order_789", "order", WithExternalID("ext_1"))
+	assert.True(t, EntitiesEqual(fromString, order), "untyped references match any type")
+	assert.True(t, EntitiesEqual(order, NewEntity("order_789", "order")))
+	assert.False(t, EntitiesEqual(order, NewEntity("order_789", "ticket")))
+	assert.False(t, EntitiesEqual(order, NewEntity("order_000", "order")))
+
+	base := NewEntity("order_789", "order", WithExternalID("ext_1"), WithEntityMetadata(map[string]interface{}{
+		"priority": "high",
+		"shipping": map[string]interface{}{"carrier": "ups"},
+	}))
+	overlay := NewEntity("order_789", "", WithExternalID("ext_2"), WithEntitySystem("shopify"), WithEntityMetadata(map[string]interface{}{
+		"priority": "low",
+		"shipping": map[string]interface{}{"speed": "express"},
+	}))
+
+	merged := MergeEntity(base, overlay)
+	assert.Equal(t, "order", merged.Type)
+	assert.Equal(t, "ext_1", *merged.ExternalID, "base wins conflicts by default")
+	assert.Equal(t, "shopify", *merged.System, "empty fields are filled from the overlay")
+	assert.Equal(t, map[string]interface{}{
+		"priority": "high",
+		"shipping": map[string]interface{}{"carrier": "ups", "speed": "express"},
+	}, merged.Metadata)
+
+	merged = MergeEntity(base, overlay, PreferOverlay(true))
+	assert.Equal(t, "order", merged.Type, "empty overlay fields never replace base values")
+	assert.Equal(t, "ext_2", *merged.ExternalID)
+	assert.Equal(t, "low", merged.Metadata["priority"])
+	assert.Equal(t, map[string]interface{}{"carrier": "ups", "speed": "express"}, merged.Metadata["shipping"])
+
+	// Neither input is modified
+	assert.Equal(t, "ext_1", *base.ExternalID)
+	assert.Nil(t, base.System)
+	assert.Equal(t, map[string]interface{}{"carrier": "ups"}, base.Metadata["shipping"])
+	assert.Equal(t, map[string]interface{}{"speed": "express"}, overlay.Metadata["shipping"])
+}
 
-	// Customer provides email
-	factEmail := NewFact("customer_456", "customer_456", "email", "john@example.com",
-		WithOperation(FieldOperationSet),
-		WithValidationStatus(ValidationStatusValid))
+func TestNormalizeEntityRefs(t *testing.T) {
+	conversation := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	conversation.AddAct(NewFact("customer_456", "order_789", "email", "user@example.com"))
+	conversation.AddAct(NewFact("customer_456", NewEntity("order_789", "order", WithExternalID("ext_1")), "quantity", 2))
+	conversation.AddAct(NewConfirm("agent_123", map[string]interface{}{"id": "order_789", "type": "order", "system": "shopify"}, "Order details confirmed"))
+	conversation.AddAct(NewCommit("agent_123", "order_789", CommitActionCreate))
+	conversation.AddAct(NewFact("customer_456", NewEntity("cust_1", "customer"), "name", "Jane"))
+	conversation.AddAct(NewFact("customer_456", NewEntity("cust_1", "account"), "tier", "gold"))
+	conversation.AddAct(NewFact("customer_456", 42, "bogus", "value"))
+
+	conversation.NormalizeEntityRefs()
+
+	want := NewEntity("order_789", "order", WithExternalID("ext_1"), WithEntitySystem("shopify"))
+	for i := 0; i < 4; i++ {
+		var ref EntityRef
+		switch a := conversation.Acts[i].(type) {
+		case Fact:
+			ref = a.Entity
+		case Confirm:
+			ref = a.Entity
+		case Commit:
+			ref = a.Entity
+		}
+		assert.Equal(t, want, ref, "act %d", i)
+	}
 
-	err = conv.AddAct(factEmail)
-	assert.NoError(t, err)
-	assert.Equal(t, 2, len(conv.Acts))
+	// Conflicting types are normalized but not combined
+	assert.Equal(t, NewEntity("cust_1", "customer"), conversation.Acts[4].(Fact).Entity)
+	assert.Equal(t, NewEntity("cust_1", "account"), conversation.Acts[5].(Fact).Entity)
+	assert.Equal(t, 42, conversation.Acts[6].(Fact).Entity)
 
-	// Agent confirms the information
-	confirm := NewConfirm("agent_123", "customer_456", "Your email is john@example.com, is that correct?",
-		WithAwaiting(true))
+	// The normalized form survives a JSON round trip
+	data, err := json.Marshal(conversation.Acts[:4])
+	require.NoError(t, err)
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	for _, act := range decoded {
+		assert.Equal(t, decoded[0]["entity"], act["entity"])
+	}
 
-	err = conv.AddAct(confirm)
-	assert.NoError(t, err)
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com")
+	fact.SetEntity(want)
+	assert.Equal(t, want, fact.Entity)
+	fact.SetEntityID("order_790")
+	assert.Equal(t, Entity{ID: "order_790"}, fact.Entity)
+}
 
-	// Customer confirms
-	confirmResponse := NewFact("customer_456", confirm.ID, "confirmed", true,
-		WithOperation(FieldOperationSet))
+func TestDedupeParticipants(t *testing.T) {
+	crmID := "crm_42"
+	web := NewParticipant("web_visitor_1", ParticipantTypeHuman,
+		WithEmail("Jane.Doe@example.com"),
+		WithCapabilities([]string{"chat"}),
+	)
+	phone := NewParticipant("caller_7", ParticipantTypeHuman,
+		WithName("Jane Doe"),
+		WithPhone("+15555550100"),
+		WithEmail(" jane.doe@example.com "),
+		WithCapabilities([]string{"voice", "chat"}),
+		WithPermissions([]string{"read"}),
+	)
+	phone.ExternalID = &crmID
+	phone.Metadata = map[string]interface{}{"source": "ivr"}
+	agent := NewParticipant("agent_123", ParticipantTypeAI)
+	input := []Participant{web, agent, phone}
+
+	merged, remap := DedupeParticipants(input)
+
+	require.Len(t, merged, 2)
+	jane := merged[0]
+	assert.Equal(t, "web_visitor_1", jane.ID)
+	assert.Equal(t, "Jane.Doe@example.com", *jane.Email)
+	assert.Equal(t, "Jane Doe", *jane.Name)
+	assert.Equal(t, "+15555550100", *jane.Phone)
+	assert.Equal(t, "crm_42", *jane.ExternalID)
+	assert.Equal(t, []string{"chat", "voice"}, jane.Capabilities)
+	assert.Equal(t, []string{"read"}, jane.Permissions)
+	assert.Equal(t, map[string]interface{}{"source": "ivr"}, jane.Metadata)
+	assert.Equal(t, agent, merged[1])
+	assert.Equal(t, map[string]string{"caller_7": "web_visitor_1"}, remap)
+
+	// The input is left as it was
+	assert.Equal(t, []string{"chat"}, input[0].Capabilities)
+	assert.Nil(t, input[0].Name)
+
+	// Matching is transitive: a shares an email with b, and b an external ID with c
+	a := NewParticipant("a", ParticipantTypeHuman, WithEmail("x@example.com"))
+	b := NewParticipant("b", ParticipantTypeHuman, WithEmail("x@example.com"))
+	b.ExternalID = &crmID
+	c := NewParticipant("c", ParticipantTypeHuman)
+	c.ExternalID = &crmID
+	merged, remap = DedupeParticipants([]Participant{c, a, b})
+	require.Len(t, merged, 1)
+	assert.Equal(t, "c", merged[0].ID)
+	assert.Equal(t, map[string]string{"a": "c", "b": "c"}, remap)
+
+	merged, remap = DedupeParticipants(nil)
+	assert.Empty(t, merged)
+	assert.Empty(t, remap)
+}
 
-	err = conv.AddAct(confirmResponse)
-	assert.NoError(t, err)
+func TestDiffConversations(t *testing.T) {
+	raw := newWorkflowConversation(t)
+	corrected := raw.Clone()
+
+	// The editor fixes a misheard email, drops an act, and adds a confirmation
+	fact := corrected.Acts[1].(Fact)
+	fact.Value = "john.doe@example.com"
+	originalText := "john dot doe at example dot com"
+	fact.Metadata = &ActMetadata{OriginalText: &originalText}
+	corrected.Acts[1] = fact
+	removedID := corrected.Acts[2].GetAct().ID
+	corrected.Acts = append(corrected.Acts[:2], corrected.Acts[3:]...)
+	confirm := NewConfirm("agent_123", "customer_456", "Is john.doe@example.com right?")
+	corrected.Acts = append(corrected.Acts, confirm)
+
+	name := "Johnny Doe"
+	corrected.Participants[1].Name = &name
+	corrected.Participants = append(corrected.Participants, NewParticipant("reviewer_1", ParticipantTypeHuman))
+
+	diff := DiffConversations(raw, corrected)
+	require.False(t, diff.IsEmpty())
+
+	require.Len(t, diff.AddedActs, 1)
+	assert.Equal(t, confirm.ID, diff.AddedActs[0].GetAct().ID)
+	assert.Equal(t, []string{removedID}, diff.RemovedActs)
+
+	require.Len(t, diff.ModifiedActs, 1)
+	assert.Equal(t, fact.ID, diff.ModifiedActs[0].ID)
+	assert.Equal(t, []FieldChange{
+		{Field: "metadata", Old: nil, New: map[string]interface{}{"original_text": originalText}},
+		{Field: "value", Old: "john@example.com", New: "john.doe@example.com"},
+	}, diff.ModifiedActs[0].Changes)
+
+	require.Len(t, diff.ModifiedParticipants, 1)
+	assert.Equal(t, []FieldChange{{Field: "name", Old: "John Doe", New: "Johnny Doe"}}, diff.ModifiedParticipants[0].Changes)
+	require.Len(t, diff.AddedParticipants, 1)
+	assert.Empty(t, diff.RemovedParticipants)
+
+	text := diff.String()
+	assert.Contains(t, text, "~ act "+fact.ID+" (fact)\n    metadata: null -> {\"original_text\":\""+originalText+"\"}")
+	assert.Contains(t, text, `    value: "john@example.com" -> "john.doe@example.com"`)
+	assert.Contains(t, text, "+ act "+confirm.ID+" (confirm)")
+	assert.Contains(t, text, "- act "+removedID)
+	assert.Contains(t, text, "+ participant reviewer_1")
+
+	// Identical conversations have no diff
+	assert.True(t, DiffConversations(raw, raw.Clone()).IsEmpty())
+	assert.Equal(t, "no changes", DiffConversations(raw, raw).String())
+
+	// Numbers compare by value, so a round trip through JSON is not a change
+	order := raw.Clone()
+	require.NoError(t, order.AddAct(NewFact("customer_456", "order_1", "quantity", 2)))
+	decoded := order.Clone()
+	quantity := decoded.Acts[len(decoded.Acts)-1].(Fact)
+	quantity.Value = 2.0
+	decoded.Acts[len(decoded.Acts)-1] = quantity
+	assert.True(t, DiffConversations(order, decoded).IsEmpty())
+}
 
-	// System commits the information
-	commit := NewCommit("system_001", "customer_456", CommitActionUpdate,
-		WithSystem("customer_management"),
-		WithCommitStatus(CommitStatusSuccess))
+func TestCloneAct(t *testing.T) {
+	original := NewError("system_001", "CRM_TIMEOUT", "CRM timed out", true,
+		WithSeverity(ErrorSeverityWarning),
+		WithErrorDetail("endpoint", "/orders"),
+		WithErrorDetail("retries", []interface{}{1, 2}),
+		WithAct[Error](WithChannel("voice")))
+
+	var act ConversationAct = original
+	clone := CloneAct(act).(Error)
+	clone.Details["endpoint"] = "/customers"
+	clone.Details["retries"].([]interface{})[0] = 9
+	*clone.Severity = ErrorSeverityCritical
+	*clone.Metadata.Channel = "sms"
+
+	assert.Equal(t, "/orders", original.Details["endpoint"])
+	assert.Equal(t, []interface{}{1, 2}, original.Details["retries"])
+	assert.Equal(t, ErrorSeverityWarning, *original.Severity)
+	assert.Equal(t, "voice", *original.Metadata.Channel)
+
+	// Every act type comes back as the same type
+	for _, act := range []ConversationAct{
+		NewAsk("agent_123", "email", "What's your email?"),
+		NewFact("customer_456", NewEntity("order_789", "order"), "email", "user@example.com"),
+		NewConfirm("agent_123", "order_789", "Order details confirmed"),
+		NewCommit("system", "order_789", CommitActionCreate),
+		original,
+	} {
+		assert.Equal(t, act, CloneAct(act))
+	}
 
-	err = conv.AddAct(commit)
-	assert.NoError(t, err)
+	// Acts added in a diff are not shared with the conversation they came from
+	old := NewConversation([]Participant{NewParticipant("system_001", ParticipantTypeSystem)})
+	updated := old.Clone()
+	updated.Acts = append(updated.Acts, original)
+	diff := DiffConversations(old, updated)
+	require.Len(t, diff.AddedActs, 1)
+	updated.Acts[0].(Error).Details["endpoint"] = "/payments"
+	assert.Equal(t, "/orders", diff.AddedActs[0].(Error).Details["endpoint"])
+}
 
-	// End the conversation
-	conv.EndConversation(ConversationStatusCompleted)
+func TestApplyDefaults(t *testing.T) {
+	act, err := UnmarshalAct([]byte(`{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "What's your email?"}`))
+	require.NoError(t, err)
+	require.Nil(t, act.(Ask).Required)
+
+	ask := ApplyDefaults(act).(Ask)
+	require.NotNil(t, ask.Required)
+	assert.True(t, *ask.Required)
+	assert.Equal(t, 0, *ask.RetryCount)
+	assert.Equal(t, 3, *ask.MaxRetries)
+	assert.Nil(t, act.(Ask).Required, "the original act is not modified")
+
+	// Values already set are kept
+	optional := ApplyDefaults(NewAsk("agent_123", "referral", "How did you hear about us?", WithRequired(false))).(Ask)
+	assert.False(t, *optional.Required)
+
+	fact := ApplyDefaults(NewFact("customer_456", "customer_456", "email", "jane@example.com")).(Fact)
+	assert.Equal(t, FieldOperationSet, *fact.Operation)
+	assert.Equal(t, ValidationStatusPending, *fact.ValidationStatus)
+
+	// A confirm that already records its answer is not awaiting one
+	question := ApplyDefaults(NewConfirm("agent_123", "order_789", "Two pizzas?")).(Confirm)
+	assert.True(t, *question.Awaiting)
+	answer := NewConfirm("customer_456", "order_789", "Yes")
+	confirmed := true
+	answer.Confirmed = &confirmed
+	assert.Nil(t, ApplyDefaults(answer).(Confirm).Awaiting)
+
+	commit := ApplyDefaults(NewCommit("system", "order_789", CommitActionCreate)).(Commit)
+	assert.Equal(t, CommitStatusPending, *commit.Status)
+	assert.Equal(t, 0, *commit.RetryCount)
+	assert.Equal(t, 3, *commit.MaxRetries)
+
+	errorAct := ApplyDefaults(NewError("system", "TIMEOUT", "Timed out", true)).(Error)
+	assert.Equal(t, ErrorSeverityError, *errorAct.Severity)
+
+	conv, err := UnmarshalConversation([]byte(`{"id": "conv_1", "participants": [{"id": "agent_123", "type": "ai"}], "acts": [{"id": "act_1", "timestamp": "2025-01-15T14:30:00Z", "speaker": "agent_123", "type": "ask", "field": "email", "prompt": "What's your email?"}]}`), false)
+	require.NoError(t, err)
+	require.Nil(t, conv.Status)
+	conv.ApplyDefaults()
+	assert.Equal(t, ConversationStatusActive, *conv.Status)
+	assert.True(t, *conv.Acts[0].(Ask).Required)
+}
 
-	// Verify final state
-	assert.Equal(t, ConversationStatusCompleted, *conv.Status)
-	assert.Equal(t, 5, len(conv.Acts))
-	assert.NotNil(t, conv.EndedAt)
-	assert.NotNil(t, conv.Metadata)
-	assert.Equal(t, 5, *conv.Metadata.ActCount)
-	assert.Equal(t, 1, *conv.Metadata.CommitCount)
-	assert.Equal(t, 0, *conv.Metadata.ErrorCount)
+func TestValuesEqual(t *testing.T) {
+	assert.True(t, ValuesEqual(42, 42.0))
+	assert.True(t, ValuesEqual(int64(42), uint8(42)))
+	assert.False(t, ValuesEqual(42, 43.0))
+	assert.False(t, ValuesEqual(42, "42"))
+	assert.True(t, ValuesEqual(ActTypeAsk, "ask"))
+	assert.True(t, ValuesEqual(nil, nil))
+	assert.False(t, ValuesEqual(nil, ""))
+
+	count := 42
+	assert.True(t, ValuesEqual(&count, 42.0))
+	var missing *int
+	assert.True(t, ValuesEqual(missing, nil))
+
+	assert.True(t, ValuesEqual([]string{"a", "b"}, []interface{}{"a", "b"}))
+	assert.False(t, ValuesEqual([]string{"a", "b"}, []string{"b", "a"}))
+	assert.False(t, ValuesEqual([]int{1}, []int{1, 2}))
+
+	// Nested maps compare as they would after a JSON round trip
+	typed := map[string]interface{}{
+		"quantity": 2,
+		"address":  map[string]string{"city": "Austin"},
+		"items":    []map[string]int{{"sku": 7}},
+	}
+	decoded := map[string]interface{}{
+		"quantity": 2.0,
+		"address":  map[string]interface{}{"city": "Austin"},
+		"items":    []interface{}{map[string]interface{}{"sku": 7.0}},
+	}
+	assert.True(t, ValuesEqual(typed, decoded))
+	decoded["address"] = map[string]interface{}{"city": "Dallas"}
+	assert.False(t, ValuesEqual(typed, decoded))
+	assert.False(t, ValuesEqual(map[string]int{"n": 1}, map[string]int{"m": 1}))
 
-	// Test querying methods
-	asks := conv.GetActsByType(ActTypeAsk)
-	assert.Equal(t, 1, len(asks))
+	// Structs compare in their JSON form
+	assert.True(t, ValuesEqual(NewEntity("order_1", "order"), map[string]interface{}{"id": "order_1", "type": "order"}))
 
-	facts := conv.GetActsByType(ActTypeFact)
-	assert.Equal(t, 2, len(facts))
+	// Large integers keep their precision
+	assert.False(t, ValuesEqual(int64(1<<53+1), int64(1<<53)))
+	assert.False(t, ValuesEqual(func() {}, func() {}))
+}
 
-	agentActs := conv.GetActsBySpeaker("agent_123")
-	assert.Equal(t, 2, len(agentActs))
+func TestFactIsNoOp(t *testing.T) {
+	unchanged := NewFact("customer_456", "order_1", "quantity", 2, WithPreviousValue(2.0))
+	assert.True(t, unchanged.IsNoOp())
 
-	customerActs := conv.GetActsBySpeaker("customer_456")
-	assert.Equal(t, 2, len(customerActs))
+	changed := NewFact("customer_456", "order_1", "quantity", 3, WithPreviousValue(2.0))
+	assert.False(t, changed.IsNoOp())
 
-	// Test JSON serialization of the complete conversation
-	jsonData, err := json.Marshal(conv)
-	assert.NoError(t, err)
-	assert.NotEmpty(t, jsonData)
+	unknown := NewFact("customer_456", "order_1", "quantity", 2)
+	assert.False(t, unknown.IsNoOp())
 
-	var unmarshaledConv Conversation
-	err = json.Unmarshal(jsonData, &unmarshaledConv)
-	assert.NoError(t, err)
-	assert.Equal(t, conv.ID, unmarshaledConv.ID)
-	assert.Equal(t, len(conv.Acts), len(unmarshaledConv.Acts))
+	increment := NewFact("customer_456", "order_1", "quantity", 2,
+		WithOperation(FieldOperationIncrement), WithPreviousValue(2))
+	assert.False(t, increment.IsNoOp())
 }
 
 func TestErrorHandlingWorkflow(t *testing.T) {
@@ -1251,6 +5240,39 @@ func TestErrorHandlingWorkflow(t *testing.T) {
 // Edge Cases and Error Conditions
 // ============================================================================
 
+func TestBackfilledTimestamps(t *testing.T) {
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	act := CreateBaseAct("agent_123", ActTypeAsk, WithTimestamp(start))
+	assert.Equal(t, start, act.Timestamp)
+
+	// A zero timestamp is ignored, or reported by the checked variant
+	act = CreateBaseAct("agent_123", ActTypeAsk, WithTimestamp(time.Time{}))
+	assert.False(t, act.Timestamp.IsZero())
+	_, err := WithTimestampE(time.Time{})
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	}, WithStartedAt(start))
+	require.NoError(t, conv.AddAct(Ask{
+		Act:    CreateBaseAct("agent_123", ActTypeAsk, WithTimestamp(start)),
+		Field:  "email",
+		Prompt: "What's your email?",
+	}))
+	require.NoError(t, conv.AddAct(Fact{
+		Act:    CreateBaseAct("customer_456", ActTypeFact, WithTimestamp(start.Add(30*time.Second))),
+		Entity: "order_789",
+		Field:  "email",
+		Value:  "user@example.com",
+	}))
+	require.NoError(t, conv.EndConversationAt(ConversationStatusCompleted, start.Add(90*time.Second)))
+
+	assert.True(t, conv.IsChronological())
+	require.NotNil(t, conv.Metadata.TotalDurationMs)
+	assert.Equal(t, int64(90000), *conv.Metadata.TotalDurationMs)
+}
+
 func TestInvalidConfidenceValues(t *testing.T) {
 	// Test invalid confidence values are ignored
 	act1 := CreateBaseAct("agent_123", ActTypeAsk, WithConfidence(-0.1))
@@ -1264,6 +5286,296 @@ func TestInvalidConfidenceValues(t *testing.T) {
 	assert.Equal(t, 0.5, *act3.Confidence)
 }
 
+func TestWithAct(t *testing.T) {
+	timestamp := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	ask := NewAsk("agent_123", "email", "What's your email?",
+		WithRequired(true),
+		WithAct[Ask](WithConfidence(0.9), WithSource(SourceAI), WithTimestamp(timestamp)))
+	require.NotNil(t, ask.Confidence)
+	assert.Equal(t, 0.9, *ask.Confidence)
+	assert.Equal(t, SourceAI, *ask.Source)
+	assert.Equal(t, timestamp, ask.Timestamp)
+	assert.True(t, *ask.Required)
+	assert.Equal(t, ActTypeAsk, ask.Type)
+	assert.NoError(t, ValidateAct(ask))
+
+	language := "en"
+	fact := NewFact("customer_456", "order_789", "email", "user@example.com",
+		WithAct[Fact](WithChannel("sms")),
+		WithAct[Fact](WithMetadata(ActMetadata{Language: &language})))
+	assert.Equal(t, "sms", *fact.Metadata.Channel)
+	assert.Equal(t, "en", *fact.Metadata.Language)
+
+	confirm := NewConfirm("agent_123", "order_789", "Confirm?", WithAct[Confirm](WithSource(SourceSystem)))
+	assert.Equal(t, SourceSystem, *confirm.Source)
+
+	commit := NewCommit("system", "order_789", CommitActionCreate, WithAct[Commit](WithTimestamp(timestamp)))
+	assert.Equal(t, timestamp, commit.Timestamp)
+
+	errorAct, err := NewErrorE("system", "E1", "failed", true, WithAct[Error](WithConfidence(0.5)))
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, *errorAct.Confidence)
+
+	// Out-of-range values are dropped as they are by CreateBaseAct
+	ask = NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](WithConfidence(1.5)))
+	assert.Nil(t, ask.Confidence)
+}
+
+func TestIncrementalBuilders(t *testing.T) {
+	// The field is recognized before the prompt has finished
+	askBuilder := NewAskBuilder("agent_123", WithRequired(true)).SetField("email")
+	assert.False(t, askBuilder.IsComplete())
+	_, err := askBuilder.Build()
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	askBuilder.SetPrompt("What's your").SetPrompt("What's your email?")
+	require.True(t, askBuilder.IsComplete())
+	ask, err := askBuilder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, "email", ask.Field)
+	assert.Equal(t, "What's your email?", ask.Prompt)
+	assert.True(t, *ask.Required)
+	assert.Equal(t, ActTypeAsk, ask.Type)
+
+	// Complete but invalid acts are still rejected by Build
+	askBuilder.Apply(WithAct[Ask](func(a *Act) { a.Speaker = "" }))
+	assert.True(t, askBuilder.IsComplete())
+	_, err = askBuilder.Build()
+	assert.Error(t, err)
+
+	// The value trickles in after the entity and field
+	factBuilder := NewFactBuilder("customer_456", WithOperation(FieldOperationSet))
+	factBuilder.SetEntity(NewEntity("order_789", "order")).SetField("quantity")
+	assert.False(t, factBuilder.IsComplete())
+	_, err = factBuilder.Build()
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "value", validationErr.Field)
+
+	factBuilder.SetValue(2).Apply(WithAct[Fact](WithConfidence(0.8)))
+	require.True(t, factBuilder.IsComplete())
+	fact, err := factBuilder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, 2, fact.Value)
+	assert.Equal(t, FieldOperationSet, *fact.Operation)
+	assert.Equal(t, 0.8, *fact.Confidence)
+
+	// Built acts do not change as the builder keeps going
+	factBuilder.SetValue(3).Apply(WithAct[Fact](WithConfidence(0.95)))
+	assert.Equal(t, 2, fact.Value)
+	assert.Equal(t, 0.8, *fact.Confidence)
+	rebuilt, err := factBuilder.Build()
+	require.NoError(t, err)
+	assert.Equal(t, fact.ID, rebuilt.ID)
+	assert.Equal(t, 3, rebuilt.Value)
+}
+
+func TestErrorDetailOptions(t *testing.T) {
+	base := map[string]interface{}{"endpoint": "/orders", "attempt": 1}
+	errorAct := NewError("system_001", "CRM_TIMEOUT", "CRM timed out", true,
+		WithErrorDetails(base),
+		WithErrorDetail("timeout_ms", 5000),
+		WithErrorDetail("attempt", 2),
+		WithStackTrace("goroutine 1 [running]:\nmain.main()"))
+
+	// Details accumulate, with later options winning per key
+	assert.Equal(t, map[string]interface{}{"endpoint": "/orders", "attempt": 2, "timeout_ms": 5000}, errorAct.Details)
+	assert.Equal(t, map[string]interface{}{"endpoint": "/orders", "attempt": 1}, base, "the caller's map is not modified")
+	require.NotNil(t, errorAct.StackTrace)
+	assert.Equal(t, "goroutine 1 [running]:\nmain.main()", *errorAct.StackTrace)
+
+	errorAct = NewError("system_001", "CRM_TIMEOUT", "CRM timed out", true, WithErrorDetail("region", "eu"))
+	assert.Equal(t, map[string]interface{}{"region": "eu"}, errorAct.Details)
+	errorAct = NewError("system_001", "CRM_TIMEOUT", "CRM timed out", true, WithErrorDetails(nil))
+	assert.Nil(t, errorAct.Details)
+}
+
+func TestCheckedOptions(t *testing.T) {
+	_, err := WithConfidenceE(1.5)
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "confidence", validationErr.Field)
+	assert.ErrorIs(t, err, ErrInvalidField)
+
+	withConfidence, err := WithConfidenceE(0.5)
+	require.NoError(t, err)
+	act := CreateBaseAct("agent_123", ActTypeAsk, withConfidence)
+	require.NotNil(t, act.Confidence)
+	assert.Equal(t, 0.5, *act.Confidence)
+
+	_, err = WithMaxRetriesE(-1)
+	assert.ErrorIs(t, err, ErrInvalidField)
+	withMaxRetries, err := WithMaxRetriesE(3)
+	require.NoError(t, err)
+	ask, err := NewAskE("agent_123", "email", "What's your email?", withMaxRetries)
+	require.NoError(t, err)
+	assert.Equal(t, 3, *ask.MaxRetries)
+
+	_, err = WithTimeoutMsE(-1)
+	assert.ErrorIs(t, err, ErrInvalidField)
+	withTimeout, err := WithTimeoutMsE(30000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30000), *NewConfirm("agent_123", "order_789", "Confirm?", withTimeout).TimeoutMs)
+
+	_, err = WithRelatedActIDE("not an id")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "related_act_id", validationErr.Field)
+	relatedID := GenerateActID()
+	withRelated, err := WithRelatedActIDE(relatedID)
+	require.NoError(t, err)
+	assert.Equal(t, relatedID, *NewError("system", "E1", "failed", true, withRelated).RelatedActID)
+}
+
+func TestWithMetadataProperty(t *testing.T) {
+	ask := NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](
+		WithMetadataProperty("trace_id", "abc123"),
+		WithChannel("voice"),
+		WithMetadataProperty("tenant", "acme"),
+		WithMetadataProperty("language", "en-US"),
+		WithMetadataProperty("processing_time_ms", 12),
+	))
+
+	require.NotNil(t, ask.Metadata)
+	assert.Equal(t, map[string]interface{}{"trace_id": "abc123", "tenant": "acme"}, ask.Metadata.AdditionalProperties)
+	assert.Equal(t, "voice", *ask.Metadata.Channel)
+	assert.Equal(t, "en-US", *ask.Metadata.Language)
+	assert.Equal(t, 12.0, *ask.Metadata.ProcessingTimeMs)
+
+	data, err := json.Marshal(ask)
+	require.NoError(t, err)
+	assert.NoError(t, ValidateJSON(data, "ask"))
+
+	// Reserved keys with values of the wrong type are rejected
+	_, err = WithMetadataPropertyE("channel", 42)
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "metadata.channel", validationErr.Field)
+	_, err = WithMetadataPropertyE("processing_time_ms", -1)
+	assert.Error(t, err)
+	_, err = WithMetadataPropertyE("", "value")
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	ignored := NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](WithMetadataProperty("channel", 42)))
+	assert.Nil(t, ignored.Metadata)
+}
+
+func TestRegisterSource(t *testing.T) {
+	ivr := Source("ivr")
+
+	ask := NewAsk("agent_123", "email", "What's your email?")
+	ask.Source = &ivr
+	assert.Error(t, ValidateAct(ask), "unregistered sources should be rejected")
+
+	RegisterSource(ivr)
+	assert.NoError(t, ValidateAct(ask))
+
+	// The schemas are not extended by registration
+	data, err := json.Marshal(ask)
+	require.NoError(t, err)
+	assert.ErrorContains(t, ValidateJSON(data, "ask"), "value ivr not in enum")
+
+	// Built-in sources remain valid
+	for _, source := range []Source{SourceHuman, SourceSpeechRecognition, SourceTextAnalysis, SourceSystem, SourceAI} {
+		builtin := source
+		ask.Source = &builtin
+		assert.NoError(t, ValidateAct(ask))
+	}
+
+	ocr := Source("ocr")
+	ask.Source = &ocr
+	assert.Error(t, ValidateAct(ask))
+
+	// Sources outside the enum fail validation however they are set
+	guess := NewAsk("agent_123", "email", "What's your email?", WithAct[Ask](WithSource(Source("guess"))))
+	var validationErr ValidationError
+	require.ErrorAs(t, ValidateAct(guess), &validationErr)
+	assert.Equal(t, "source", validationErr.Field)
+	_, err = WithSourceE(Source("guess"))
+	assert.ErrorIs(t, err, ErrInvalidField)
+	option, err := WithSourceE(ivr)
+	require.NoError(t, err)
+	assert.NotNil(t, option)
+}
+
+func TestParseEnums(t *testing.T) {
+	actType, err := ParseActType("confirm")
+	require.NoError(t, err)
+	assert.Equal(t, ActTypeConfirm, actType)
+
+	_, err = ParseActType("Confirm")
+	var enumErr InvalidEnumValueError
+	require.ErrorAs(t, err, &enumErr)
+	assert.Equal(t, "act type", enumErr.Enum)
+	assert.Equal(t, "Confirm", enumErr.Value)
+
+	status, err := ParseConversationStatus("paused")
+	require.NoError(t, err)
+	assert.Equal(t, ConversationStatusPaused, status)
+	_, err = ParseCommitStatus("done")
+	assert.EqualError(t, err, `invalid commit status: "done"`)
+
+	assert.Equal(t, []ActType{ActTypeAsk, ActTypeFact, ActTypeConfirm, ActTypeCommit, ActTypeError}, ValidActTypes())
+	for _, severity := range ValidErrorSeverities() {
+		parsed, err := ParseErrorSeverity(string(severity))
+		require.NoError(t, err)
+		assert.Equal(t, severity, parsed)
+	}
+
+	// Callers cannot modify the package's lists
+	types := ValidActTypes()
+	types[0] = "bogus"
+	assert.Equal(t, ActTypeAsk, ValidActTypes()[0])
+
+	// Sources include registered custom sources
+	_, err = ParseSource("kiosk")
+	assert.Error(t, err)
+	RegisterSource("kiosk")
+	source, err := ParseSource("kiosk")
+	require.NoError(t, err)
+	assert.Equal(t, Source("kiosk"), source)
+	assert.Contains(t, ValidSources(), Source("kiosk"))
+	assert.Equal(t, SourceHuman, ValidSources()[0])
+}
+
+func TestValidationErrorMatching(t *testing.T) {
+	conv := NewConversation([]Participant{NewParticipant("customer_456", ParticipantTypeHuman)})
+
+	// The failing field survives the wrapping in ValidateAct and AddAct
+	err := conv.AddAct(NewFact("customer_456", "order_789", "", "user@example.com"))
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "field", validationErr.Field)
+	assert.ErrorIs(t, err, ErrMissingField)
+	assert.NotErrorIs(t, err, ErrInvalidField)
+
+	ask := NewAsk("customer_456", "email", "What's your email?")
+	confidence := 1.5
+	ask.Confidence = &confidence
+	err = conv.AddAct(ask)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "confidence", validationErr.Field)
+	assert.ErrorIs(t, err, ErrInvalidField)
+
+	ask = NewAsk("customer_456", "email", "What's your email?")
+	ask.Speaker = ""
+	err = ValidateAct(ask)
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "speaker", validationErr.Field)
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	ask = NewAsk("customer_456", "email", "What's your email?")
+	ask.Type = "question"
+	err = conv.AddAct(ask)
+	var actTypeErr InvalidActTypeError
+	require.ErrorAs(t, err, &actTypeErr)
+	assert.Equal(t, "question", actTypeErr.ActType)
+	assert.ErrorIs(t, err, ErrInvalidActType)
+
+	_, err = conv.GetActByID("act_missing")
+	assert.ErrorIs(t, err, ErrActNotFound)
+}
+
 func TestNegativeRetryValues(t *testing.T) {
 	// Test that negative retry values are ignored
 	ask := NewAsk("agent_123", "email", "What's your email?", WithMaxRetries(-1))
@@ -1307,3 +5619,382 @@ func TestEmptyConversationValidation(t *testing.T) {
 
 	assert.True(t, IsConversation(validConv))
 }
+
+func BenchmarkConversationUnmarshal(b *testing.B) {
+	data := newLargeConversationJSON(b, 1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var conv Conversation
+		json.Unmarshal(data, &conv)
+	}
+}
+
+func BenchmarkDecodeConversationStream(b *testing.B) {
+	data := newLargeConversationJSON(b, 1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		DecodeConversationStream(bytes.NewReader(data), func(ConversationAct) error { return nil })
+	}
+}
+
+func BenchmarkConversationMsgpackMarshal(b *testing.B) {
+	var conv Conversation
+	if err := json.Unmarshal(newLargeConversationJSON(b, 1000), &conv); err != nil {
+		b.Fatal(err)
+	}
+	jsonData, _ := json.Marshal(conv)
+	b.ResetTimer()
+
+	var msgpackData []byte
+	for i := 0; i < b.N; i++ {
+		msgpackData, _ = MarshalConversationMsgpack(conv)
+	}
+	b.ReportMetric(float64(len(jsonData)), "json-bytes")
+	b.ReportMetric(float64(len(msgpackData)), "msgpack-bytes")
+}
+
+func TestDetectSchemaVersion(t *testing.T) {
+	version, err := DetectSchemaVersion([]byte(`{"id": "conv_123", "schema_version": "v2"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", version)
+
+	// Documents predating the field are v1
+	version, err = DetectSchemaVersion([]byte(`{"id": "conv_123"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", version)
+
+	_, err = DetectSchemaVersion([]byte(`{"schema_version": ""}`))
+	assert.Error(t, err)
+
+	_, err = DetectSchemaVersion([]byte(`{"schema_version": 2}`))
+	assert.Error(t, err)
+
+	_, err = DetectSchemaVersion([]byte(`not json`))
+	assert.Error(t, err)
+
+	conv := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	require.NotNil(t, conv.SchemaVersion)
+	data, err := json.Marshal(conv)
+	require.NoError(t, err)
+	version, err = DetectSchemaVersion(data)
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, version)
+}
+
+func TestMigrateConversation(t *testing.T) {
+	data := []byte(`{"id": "conv_123", "schema_version": "v1", "participants": [], "acts": []}`)
+
+	migrated, err := MigrateConversation(data, "v1", "v1")
+	require.NoError(t, err)
+	assert.Equal(t, data, migrated)
+
+	_, err = MigrateConversation(data, "v1", "v9")
+	assert.Error(t, err)
+
+	setVersion := func(version string) MigrationFunc {
+		return func(data []byte) ([]byte, error) {
+			var document map[string]interface{}
+			if err := json.Unmarshal(data, &document); err != nil {
+				return nil, err
+			}
+			document["schema_version"] = version
+			return json.Marshal(document)
+		}
+	}
+	RegisterMigration("test_v1", "test_v2", setVersion("test_v2"))
+	RegisterMigration("test_v2", "test_v3", setVersion("test_v3"))
+
+	// Registered steps are chained
+	migrated, err = MigrateConversation(data, "test_v1", "test_v3")
+	require.NoError(t, err)
+	version, err := DetectSchemaVersion(migrated)
+	require.NoError(t, err)
+	assert.Equal(t, "test_v3", version)
+
+	RegisterMigration("test_v3", "test_v4", func([]byte) ([]byte, error) {
+		return nil, errors.New("unsupported document")
+	})
+	_, err = MigrateConversation(data, "test_v1", "test_v4")
+	assert.ErrorContains(t, err, "migration from test_v3 to test_v4 failed")
+}
+
+func TestValidateEntityValue(t *testing.T) {
+	var fetches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		if r.URL.Path != "/order.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{
+			"type": "object",
+			"additionalProperties": false,
+			"properties": {
+				"quantity": {"type": "integer", "minimum": 1},
+				"status": {"enum": ["pending", "shipped"]},
+				"address": {"$ref": "#/definitions/address"}
+			},
+			"definitions": {
+				"address": {
+					"type": "object",
+					"required": ["street"],
+					"properties": {"street": {"type": "string"}}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	resolver := NewHTTPSchemaResolver(WithSchemaCacheTTL(time.Minute))
+	resolver.now = func() time.Time { return now }
+	order := NewEntity("order_789", "order", WithSchemaURL(server.URL+"/order.json"))
+
+	assert.NoError(t, ValidateEntityValue(resolver, order, "quantity", 2))
+	assert.NoError(t, ValidateEntityValue(resolver, order, "status", "shipped"))
+	assert.NoError(t, ValidateEntityValue(resolver, order, "address", map[string]interface{}{"street": "123 Main St"}))
+
+	err := ValidateEntityValue(resolver, order, "quantity", 0)
+	var validationErr ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "quantity", validationErr.Field)
+	assert.Error(t, ValidateEntityValue(resolver, order, "quantity", 1.5))
+	assert.Error(t, ValidateEntityValue(resolver, order, "status", "lost"))
+	assert.Error(t, ValidateEntityValue(resolver, order, "address", map[string]interface{}{"city": "Anytown"}))
+	assert.Error(t, ValidateEntityValue(resolver, order, "coupon", "SAVE10"), "closed schemas reject unknown fields")
+
+	// The schema is fetched once and reused until the TTL passes
+	assert.Equal(t, 1, fetches)
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, ValidateEntityValue(resolver, order, "quantity", 2))
+	assert.Equal(t, 2, fetches)
+
+	// Entities without a schema accept any value; unreachable schemas are errors
+	assert.NoError(t, ValidateEntityValue(resolver, NewEntity("order_1", "order"), "quantity", -1))
+	missing := NewEntity("order_2", "order", WithSchemaURL(server.URL+"/missing.json"))
+	assert.ErrorContains(t, ValidateEntityValue(resolver, missing, "quantity", 2), "404")
+	unsupported := NewEntity("order_3", "order", WithSchemaURL("file:///etc/schema.json"))
+	assert.ErrorContains(t, ValidateEntityValue(resolver, unsupported, "quantity", 2), "unsupported schema URL")
+}
+
+func TestValidateEntityValueContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	resolver := NewHTTPSchemaResolver()
+	order := NewEntity("order_789", "order", WithSchemaURL(server.URL+"/order.json"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := ValidateEntityValueContext(ctx, resolver, order, "quantity", 2)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second, "the fetch is abandoned at the deadline")
+
+	// Resolvers without context support are not called once the context is done
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, ValidateEntityValueContext(canceled, staticSchemaResolver{}, order, "quantity", 2), context.Canceled)
+}
+
+// staticSchemaResolver is an EntitySchemaResolver without context support
+type staticSchemaResolver struct{}
+
+func (staticSchemaResolver) ResolveSchema(url string) (Schema, error) {
+	panic("ResolveSchema called after the context was done")
+}
+
+func TestSchemaRegistry(t *testing.T) {
+	registry := NewSchemaRegistry()
+	assert.ErrorIs(t, registry.Register(BusinessSchema{}), ErrMissingField)
+
+	numberType := ExpectedTypeNumber
+	require.NoError(t, registry.Register(BusinessSchema{
+		ID: "customer_service_v1",
+		Fields: map[string]FieldDefinition{
+			"email":    {Constraints: []Constraint{EmailFormatConstraint()}},
+			"quantity": {Type: &numberType},
+			"status":   {Constraints: []Constraint{EnumConstraint([]string{"open", "closed"})}},
+		},
+		RequiredAsks: []string{"email"},
+		EntityTypes:  []string{"order"},
+	}))
+	assert.Equal(t, []string{"customer_service_v1"}, registry.Schemas())
+
+	_, err := registry.Lookup("sales_v2")
+	var notRegistered SchemaNotRegisteredError
+	require.ErrorAs(t, err, &notRegistered)
+	assert.Equal(t, "sales_v2", notRegistered.Schema)
+	assert.ErrorIs(t, err, ErrSchemaNotRegistered)
+
+	participants := []Participant{NewParticipant("agent_123", ParticipantTypeAI), NewParticipant("customer_456", ParticipantTypeHuman)}
+	order := NewEntity("order_789", "order")
+
+	conv := NewConversation(participants, WithConversationSchema("customer_service_v1"))
+	conv.AddAct(NewAsk("agent_123", "email", "What's your email?"))
+	conv.AddAct(NewFact("customer_456", order, "email", "user@example.com"))
+	conv.AddAct(NewFact("customer_456", "order_789", "quantity", "3"))
+	conv.AddAct(NewFact("customer_456", order, "status", nil, WithOperation(FieldOperationDelete)))
+	conv.AddAct(NewCommit("agent_123", order, CommitActionUpdate))
+	assert.Empty(t, registry.ValidateConversation(conv))
+
+	invalid := NewConversation(participants, WithConversationSchema("customer_service_v1"))
+	invalid.AddAct(NewAsk("agent_123", "nickname", "What should we call you?"))
+	invalid.AddAct(NewFact("customer_456", order, "email", "not an email"))
+	invalid.AddAct(NewFact("customer_456", order, "quantity", "three"))
+	invalid.AddAct(NewConfirm("agent_123", NewEntity("ticket_1", "ticket"), "Close the ticket?"))
+	errs := registry.ValidateConversation(invalid)
+	require.Len(t, errs, 5)
+	assert.Contains(t, errs[0].Error(), "act 0: field nickname is not defined")
+	var violation ConstraintViolation
+	assert.ErrorAs(t, errs[1], &violation)
+	var coercionErr CoercionError
+	assert.ErrorAs(t, errs[2], &coercionErr)
+	assert.Contains(t, errs[3].Error(), "type ticket")
+	assert.Contains(t, errs[4].Error(), "required ask for field email is missing")
+
+	unregistered := NewConversation(participants, WithConversationSchema("sales_v2"))
+	errs = registry.ValidateConversation(unregistered)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrSchemaNotRegistered)
+
+	errs = registry.ValidateConversation(NewConversation(participants))
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrMissingField)
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore()
+
+	conv := NewConversation([]Participant{
+		NewParticipant("agent_123", ParticipantTypeAI),
+		NewParticipant("customer_456", ParticipantTypeHuman),
+	})
+	require.NoError(t, conv.AddAct(NewFact("customer_456", "order_789", "email", "user@example.com")))
+	other := NewConversation([]Participant{NewParticipant("agent_123", ParticipantTypeAI)})
+	store.Put(conv)
+	store.Put(other)
+
+	got, ok := store.Get(conv.ID)
+	require.True(t, ok)
+	assert.Equal(t, conv.ID, got.ID)
+	assert.Len(t, got.Acts, 1)
+	_, ok = store.Get("conv_missing")
+	assert.False(t, ok)
+
+	both := []string{conv.ID, other.ID}
+	sort.Strings(both)
+	assert.Equal(t, both, store.ByParticipant("agent_123"))
+	assert.Equal(t, []string{conv.ID}, store.ByParticipant("customer_456"))
+	assert.Equal(t, []string{conv.ID}, store.ByEntity("order_789"))
+
+	// Changes made after Put are not visible until the conversation is put again
+	conv.Participants[1] = NewParticipant("customer_999", ParticipantTypeHuman)
+	conv.Acts[0] = NewFact("customer_999", "order_790", "email", "user@example.com")
+	assert.Equal(t, []string{conv.ID}, store.ByParticipant("customer_456"))
+
+	// Re-putting replaces the stale index entries
+	store.Put(conv)
+	assert.Empty(t, store.ByParticipant("customer_456"))
+	assert.Equal(t, []string{conv.ID}, store.ByParticipant("customer_999"))
+	assert.Empty(t, store.ByEntity("order_789"))
+	assert.Equal(t, []string{conv.ID}, store.ByEntity("order_790"))
+	assert.Equal(t, both, store.ByParticipant("agent_123"))
+
+	assert.True(t, store.Delete(other.ID))
+	assert.False(t, store.Delete(other.ID))
+	assert.Equal(t, []string{conv.ID}, store.ByParticipant("agent_123"))
+}
+
+// ============================================================================
+// Fuzz Tests
+// ============================================================================
+
+// fuzzActSeeds are act documents covering every act type and the optional
+// structures that have custom unmarshaling
+var fuzzActSeeds = []string{
+	`{"id":"act_1","timestamp":"2025-01-15T14:30:00Z","speaker":"agent_123","type":"ask","field":"email","prompt":"Email?","constraints":[{"type":"range","value":{"min":1,"max":5}}],"metadata":{"channel":"voice","trace_id":"abc"}}`,
+	`{"id":"act_2","timestamp":"2025-01-15T14:30:01Z","speaker":"customer_456","type":"fact","entity":{"id":"order_789","type":"order","metadata":{"a":[1,{"b":null}]}},"field":"items","value":[1.5e300,"x",{"y":true}],"operation":"append"}`,
+	`{"id":"act_3","timestamp":"2025-01-15T14:30:02Z","speaker":"agent_123","type":"confirm","entity":"order_789","summary":"OK?","awaiting":false,"confirmed":false,"rejection_reason":"no"}`,
+	`{"id":"act_4","timestamp":"2025-01-15T14:30:03Z","speaker":"system_001","type":"commit","entity":"order_789","action":"create","status":"failed","error":{"code":"E","message":"m","recoverable":true},"retry_count":1,"max_retries":3}`,
+	`{"id":"act_5","timestamp":"2025-01-15T14:30:04Z","speaker":"system_001","type":"error","code":"TIMEOUT","message":"m","recoverable":true,"related_act_id":"act_4","details":{"attempts":2}}`,
+	`{"type":"fact","metadata":{"Channel":"voice","channel":"sms"}}`,
+	`{"type":"ask","metadata":null,"confidence":1e999}`,
+}
+
+func FuzzUnmarshalAct(f *testing.F) {
+	for _, seed := range fuzzActSeeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		act, err := UnmarshalAct(data)
+		if err != nil {
+			return
+		}
+		_ = ValidateAct(act)
+
+		// Anything that decodes must encode and decode again to the same act
+		encoded, err := MarshalAct(act)
+		if err != nil {
+			t.Fatalf("decoded act cannot be marshaled: %v", err)
+		}
+		again, err := UnmarshalAct(encoded)
+		if err != nil {
+			t.Fatalf("marshaled act cannot be unmarshaled: %v\n%s", err, encoded)
+		}
+		reencoded, err := MarshalAct(again)
+		if err != nil {
+			t.Fatalf("round-tripped act cannot be marshaled: %v", err)
+		}
+		if !bytes.Equal(encoded, reencoded) {
+			t.Fatalf("act changed across a round trip:\n%s\n%s", encoded, reencoded)
+		}
+	})
+}
+
+func FuzzUnmarshalConversation(f *testing.F) {
+	jsonData, err := yamlToJSON([]byte(conversationYAML))
+	require.NoError(f, err)
+	f.Add(jsonData)
+	f.Add(newLargeConversationJSON(f, 4))
+	f.Add([]byte(`{"id":"conv_1","participants":[{"id":"p","type":"human","preferences":{"language":"en","x":{}}}],"acts":[null],"context":{"session_id":"s","k":[]},"metadata":{"act_count":1e2}}`))
+	for _, seed := range fuzzActSeeds {
+		f.Add([]byte(`{"id":"conv_1","participants":[],"acts":[` + seed + `]}`))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = UnmarshalConversation(data, true)
+
+		conv, err := UnmarshalConversation(data, false)
+		if err != nil {
+			return
+		}
+		_ = conv.Validate()
+
+		encoded, err := MarshalConversation(conv, false)
+		if err != nil {
+			t.Fatalf("decoded conversation cannot be marshaled: %v", err)
+		}
+		again, err := UnmarshalConversation(encoded, false)
+		if err != nil {
+			t.Fatalf("marshaled conversation cannot be unmarshaled: %v\n%s", err, encoded)
+		}
+		reencoded, err := MarshalConversation(again, false)
+		if err != nil {
+			t.Fatalf("round-tripped conversation cannot be marshaled: %v", err)
+		}
+		if !bytes.Equal(encoded, reencoded) {
+			t.Fatalf("conversation changed across a round trip:\n%s\n%s", encoded, reencoded)
+		}
+	})
+}
@@ -3,9 +3,15 @@ package astra
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	mathrand "math/rand"
+	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,35 +25,145 @@ var actIDPattern = regexp.MustCompile(`^act_[a-zA-Z0-9_-]+$`)
 // conversationIDPattern is the regex pattern for valid ASTRA conversation IDs
 var conversationIDPattern = regexp.MustCompile(`^conv_[a-zA-Z0-9_-]+$`)
 
+// entityIDPattern is the regex pattern for valid entity IDs: 1 to 256
+// characters with no whitespace or control characters
+var entityIDPattern = regexp.MustCompile(`^[^\s\p{Z}\p{C}]{1,256}$`)
+
+// IDGenerator produces the timestamp and random components of generated IDs.
+// The package-level IDSource is used by GenerateActID and the other Generate
+// functions; replace it with a seeded generator to get reproducible IDs.
+type IDGenerator struct {
+	mu     sync.Mutex
+	clock  func() time.Time
+	random *mathrand.Rand // nil for crypto/rand
+}
+
+// IDSource is the generator used by the package-level Generate functions. It
+// defaults to crypto/rand and the wall clock. Tests can install a seeded
+// generator, typically in TestMain, to get stable IDs:
+//
+//	astra.IDSource = astra.NewIDGenerator(42, nil)
+//
+// IDSource must not be replaced while IDs are being generated concurrently.
+var IDSource = &IDGenerator{clock: time.Now}
+
+// NewIDGenerator creates a deterministic generator whose random components are
+// drawn from a PRNG seeded with seed and whose timestamps come from clock. A
+// nil clock always reports the Unix epoch, so IDs depend on the seed alone.
+// Seeded IDs are predictable and must not be used where IDs need to be
+// unguessable.
+func NewIDGenerator(seed int64, clock func() time.Time) *IDGenerator {
+	if clock == nil {
+		clock = func() time.Time { return time.Unix(0, 0) }
+	}
+	return &IDGenerator{
+		clock:  clock,
+		random: mathrand.New(mathrand.NewSource(seed)),
+	}
+}
+
+// ActID generates a new ASTRA-compliant act ID
+func (g *IDGenerator) ActID() string {
+	return g.generate("act", 8)
+}
+
+// ActIDWithContext generates an act ID with caller-supplied components between
+// the act_ prefix and the usual timestamp and random suffix, for example
+// act_tenantA_voice_<timestamp>_<random>. Empty components are skipped and any
+// character outside [a-zA-Z0-9-], including the underscore separator, is
+// replaced with a dash, so the result always passes IsValidActID.
+//
+// Components add no randomness: IDs sharing the same components are exactly as
+// likely to collide as plain act IDs, which needs two IDs generated in the same
+// millisecond to draw the same 32 random bits. IDs with different components
+// cannot collide unless the components sanitize to the same text.
+func (g *IDGenerator) ActIDWithContext(parts ...string) string {
+	prefix := "act"
+	for _, part := range parts {
+		if sanitized := sanitizeIDPart(part); sanitized != "" {
+			prefix += "_" + sanitized
+		}
+	}
+	return g.generate(prefix, 8)
+}
+
+// ConversationID generates a new ASTRA-compliant conversation ID
+func (g *IDGenerator) ConversationID() string {
+	return g.generate("conv", 8)
+}
+
+// ParticipantID generates a new participant ID
+func (g *IDGenerator) ParticipantID() string {
+	return g.generate("participant", 6)
+}
+
+// EntityID generates a new entity ID
+func (g *IDGenerator) EntityID(entityType string) string {
+	if entityType == "" {
+		entityType = "entity"
+	}
+	return g.generate(entityType, 6)
+}
+
+// generate formats an ID from a prefix, the clock's millisecond timestamp in
+// base 36, and a random hex string of the given length
+func (g *IDGenerator) generate(prefix string, length int) string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	timestamp := strconv.FormatInt(g.clock().UnixNano()/1000000, 36)
+	return fmt.Sprintf("%s_%s_%s", prefix, timestamp, g.randomString(length))
+}
+
+// randomString generates a random hex string, from crypto/rand unless the
+// generator is seeded
+func (g *IDGenerator) randomString(length int) string {
+	if g.random == nil {
+		return generateRandomString(length)
+	}
+	bytes := make([]byte, length/2+1)
+	g.random.Read(bytes)
+	return hex.EncodeToString(bytes)[:length]
+}
+
 // GenerateActID generates a new ASTRA-compliant act ID
 func GenerateActID() string {
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/1000000, 36)
-	random := generateRandomString(8)
-	return fmt.Sprintf("act_%s_%s", timestamp, random)
+	return IDSource.ActID()
+}
+
+// GenerateActIDWithContext generates an act ID namespaced by caller-supplied
+// components (see IDGenerator.ActIDWithContext)
+func GenerateActIDWithContext(parts ...string) string {
+	return IDSource.ActIDWithContext(parts...)
+}
+
+// GenerateScopedActID generates an act ID scoped to a tenant or source, such as
+// act_tenantA_<timestamp>_<random>
+func GenerateScopedActID(scope string) string {
+	return IDSource.ActIDWithContext(scope)
 }
 
 // GenerateConversationID generates a new ASTRA-compliant conversation ID
 func GenerateConversationID() string {
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/1000000, 36)
-	random := generateRandomString(8)
-	return fmt.Sprintf("conv_%s_%s", timestamp, random)
+	return IDSource.ConversationID()
 }
 
 // GenerateParticipantID generates a new participant ID
 func GenerateParticipantID() string {
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/1000000, 36)
-	random := generateRandomString(6)
-	return fmt.Sprintf("participant_%s_%s", timestamp, random)
+	return IDSource.ParticipantID()
 }
 
 // GenerateEntityID generates a new entity ID
 func GenerateEntityID(entityType string) string {
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/1000000, 36)
-	random := generateRandomString(6)
-	if entityType == "" {
-		entityType = "entity"
-	}
-	return fmt.Sprintf("%s_%s_%s", entityType, timestamp, random)
+	return IDSource.EntityID(entityType)
+}
+
+// idPartPattern matches the characters that may not appear in an ID component
+var idPartPattern = regexp.MustCompile(`[^a-zA-Z0-9-]`)
+
+// sanitizeIDPart makes a caller-supplied string safe to embed in an ID
+func sanitizeIDPart(part string) string {
+	return idPartPattern.ReplaceAllString(part, "-")
 }
 
 // generateRandomString generates a cryptographically secure random string
@@ -65,16 +181,85 @@ func IsValidActID(id string) bool {
 	return actIDPattern.MatchString(id)
 }
 
+// ParsedActID holds the parts of an act ID in the form generated by
+// GenerateActID and GenerateActIDWithContext
+type ParsedActID struct {
+	// Components between the act_ prefix and the timestamp, if any
+	Context []string
+	// Generation time, to the millisecond
+	Timestamp time.Time
+	// Random suffix
+	Random string
+}
+
+// ParseActID splits an act ID in generated form, act_[<context>_...]<base 36
+// millisecond timestamp>_<8 hex digits>, into its parts. Other valid act IDs,
+// such as act_1, return a ValidationError. The form is recognized
+// heuristically: hand-written IDs that happen to match, with a timestamp
+// between 2000 and 2100, are parsed as if generated.
+func ParseActID(id string) (ParsedActID, error) {
+	notGenerated := ValidationError{Field: "id", Message: "act ID is not in generated form", Value: id}
+
+	parts := strings.Split(id, "_")
+	if len(parts) < 3 || parts[0] != "act" {
+		return ParsedActID{}, notGenerated
+	}
+	random := parts[len(parts)-1]
+	if len(random) != 8 || strings.Trim(random, "0123456789abcdef") != "" {
+		return ParsedActID{}, notGenerated
+	}
+	millis, err := strconv.ParseInt(parts[len(parts)-2], 36, 64)
+	if err != nil {
+		return ParsedActID{}, notGenerated
+	}
+	timestamp := time.UnixMilli(millis).UTC()
+	if timestamp.Year() < 2000 || timestamp.Year() >= 2100 {
+		return ParsedActID{}, notGenerated
+	}
+
+	parsed := ParsedActID{Timestamp: timestamp, Random: random}
+	if len(parts) > 3 {
+		parsed.Context = parts[1 : len(parts)-2]
+	}
+	return parsed, nil
+}
+
 // IsValidConversationID validates a conversation ID format
 func IsValidConversationID(id string) bool {
 	return conversationIDPattern.MatchString(id)
 }
 
+// IsValidEntityID validates an entity ID. Entity IDs are often assigned by
+// external systems, so any format is accepted as long as the ID is 1 to 256
+// characters long and contains no whitespace or control characters. IDs in the
+// generated form <type>_<base 36 milliseconds>_<6 hex digits> produced by
+// GenerateEntityID pass whenever the entity type does.
+func IsValidEntityID(id string) bool {
+	return entityIDPattern.MatchString(id)
+}
+
 // IsValidTimestamp validates if a time.Time is not zero
 func IsValidTimestamp(t time.Time) bool {
 	return !t.IsZero()
 }
 
+// ParseTimestamp parses an act timestamp strictly as RFC 3339, the profile of
+// ISO 8601 used by the schemas: a full date and time with an explicit "Z" or
+// numeric offset, and optional fractional seconds. Other ISO 8601 forms, such
+// as week dates or basic format without separators, are rejected. A timestamp
+// without an offset is reported as such rather than read as UTC, since
+// guessing its zone would silently skew durations computed from it.
+func ParseTimestamp(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err == nil {
+		return t, nil
+	}
+	if _, naiveErr := time.Parse("2006-01-02T15:04:05.999999999", s); naiveErr == nil {
+		return time.Time{}, ValidationError{Field: "timestamp", Message: "timestamp has no timezone offset", Value: s}
+	}
+	return time.Time{}, ValidationError{Field: "timestamp", Message: "timestamp is not in RFC 3339 format", Value: s}
+}
+
 // ============================================================================
 // Act Creation Utilities
 // ============================================================================
@@ -108,17 +293,80 @@ func WithConfidence(confidence float64) ActOption {
 	}
 }
 
-// WithSource sets the source for an act
+// WithConfidenceE returns WithConfidence(confidence), or a ValidationError if
+// confidence is outside [0.0, 1.0] and WithConfidence would ignore it
+func WithConfidenceE(confidence float64) (ActOption, error) {
+	if !(confidence >= 0.0 && confidence <= 1.0) {
+		return nil, ValidationError{Field: "confidence", Message: "confidence must be between 0.0 and 1.0", Value: confidence}
+	}
+	return WithConfidence(confidence), nil
+}
+
+// WithSource sets the source for an act. The source is not checked here;
+// ValidateAct rejects sources that are neither built in nor registered with
+// RegisterSource. Use WithSourceE to check it up front.
 func WithSource(source Source) ActOption {
 	return func(a *Act) {
 		a.Source = &source
 	}
 }
 
-// WithMetadata sets metadata for an act
+// WithSourceE is like WithSource but checks the source with ParseSource,
+// returning a ValidationError for unknown sources
+func WithSourceE(source Source) (ActOption, error) {
+	if _, err := ParseSource(string(source)); err != nil {
+		return nil, ValidationError{Field: "source", Message: "invalid act source", Value: source}
+	}
+	return WithSource(source), nil
+}
+
+// WithTimestamp sets the act's timestamp in place of the current time, for
+// backfilling acts from historical records. A zero time is ignored.
+func WithTimestamp(timestamp time.Time) ActOption {
+	return func(a *Act) {
+		if !timestamp.IsZero() {
+			a.Timestamp = timestamp
+		}
+	}
+}
+
+// WithTimestampE returns WithTimestamp(timestamp), or a ValidationError if
+// timestamp is zero and WithTimestamp would ignore it
+func WithTimestampE(timestamp time.Time) (ActOption, error) {
+	if timestamp.IsZero() {
+		return nil, ValidationError{Field: "timestamp", Message: "timestamp is required", Value: timestamp, Err: ErrMissingField}
+	}
+	return WithTimestamp(timestamp), nil
+}
+
+// WithMetadata merges metadata into an act's existing metadata. Fields set on
+// metadata override existing values; unset fields and additional properties
+// not present in metadata are preserved.
 func WithMetadata(metadata ActMetadata) ActOption {
 	return func(a *Act) {
-		a.Metadata = &metadata
+		if a.Metadata == nil {
+			a.Metadata = &ActMetadata{}
+		}
+		if metadata.Channel != nil {
+			a.Metadata.Channel = metadata.Channel
+		}
+		if metadata.Language != nil {
+			a.Metadata.Language = metadata.Language
+		}
+		if metadata.OriginalText != nil {
+			a.Metadata.OriginalText = metadata.OriginalText
+		}
+		if metadata.ProcessingTimeMs != nil {
+			a.Metadata.ProcessingTimeMs = metadata.ProcessingTimeMs
+		}
+		if len(metadata.AdditionalProperties) > 0 {
+			if a.Metadata.AdditionalProperties == nil {
+				a.Metadata.AdditionalProperties = make(map[string]interface{}, len(metadata.AdditionalProperties))
+			}
+			for k, v := range metadata.AdditionalProperties {
+				a.Metadata.AdditionalProperties[k] = v
+			}
+		}
 	}
 }
 
@@ -152,6 +400,95 @@ func WithOriginalText(originalText string) ActOption {
 	}
 }
 
+// WithMetadataProperty sets a single metadata property, creating the act's
+// metadata as needed, for tagging acts with values such as trace IDs or
+// tenants. The reserved keys channel, language, original_text, and
+// processing_time_ms set the matching typed field instead; a value of the
+// wrong type for one of them is ignored. Use WithMetadataPropertyE to be told
+// about such values.
+func WithMetadataProperty(key string, value interface{}) ActOption {
+	option, err := WithMetadataPropertyE(key, value)
+	if err != nil {
+		return func(*Act) {}
+	}
+	return option
+}
+
+// WithMetadataPropertyE is like WithMetadataProperty but returns a
+// ValidationError when the key is empty or when a reserved key is given a
+// value of the wrong type: a string for channel, language, and original_text,
+// and a non-negative number for processing_time_ms.
+func WithMetadataPropertyE(key string, value interface{}) (ActOption, error) {
+	switch key {
+	case "":
+		return nil, ValidationError{Field: "metadata", Message: "metadata property key is required", Value: key, Err: ErrMissingField}
+	case "channel", "language", "original_text":
+		s, ok := value.(string)
+		if !ok {
+			return nil, ValidationError{Field: "metadata." + key, Message: key + " must be a string", Value: value}
+		}
+		switch key {
+		case "channel":
+			return WithChannel(s), nil
+		case "language":
+			return WithLanguage(s), nil
+		default:
+			return WithOriginalText(s), nil
+		}
+	case "processing_time_ms":
+		ms, ok := toFloat64(value)
+		if !ok || !(ms >= 0) {
+			return nil, ValidationError{Field: "metadata." + key, Message: key + " must be a non-negative number", Value: value}
+		}
+		return func(a *Act) {
+			if a.Metadata == nil {
+				a.Metadata = &ActMetadata{}
+			}
+			a.Metadata.ProcessingTimeMs = &ms
+		}, nil
+	}
+
+	return func(a *Act) {
+		if a.Metadata == nil {
+			a.Metadata = &ActMetadata{}
+		}
+		if a.Metadata.AdditionalProperties == nil {
+			a.Metadata.AdditionalProperties = make(map[string]interface{})
+		}
+		a.Metadata.AdditionalProperties[key] = value
+	}, nil
+}
+
+// specializedAct lists the act types built by NewAsk, NewFact, NewConfirm,
+// NewCommit, and NewError
+type specializedAct interface {
+	Ask | Fact | Confirm | Commit | Error
+}
+
+// baseAct returns the act itself. It is promoted to the specialized act types
+// so that WithAct can reach their embedded Act.
+func (a *Act) baseAct() *Act {
+	return a
+}
+
+// WithAct adapts base ActOptions, such as WithConfidence, WithSource,
+// WithMetadata, and WithTimestamp, into an option for a specialized builder.
+// The type argument names the act being built:
+//
+//	ask := NewAsk("agent_123", "email", "What's your email?",
+//		WithRequired(true),
+//		WithAct[Ask](WithConfidence(0.9), WithSource(SourceAI)))
+//
+// The base options are applied in order, along with the builder's other options.
+func WithAct[T specializedAct](options ...ActOption) func(*T) {
+	return func(act *T) {
+		base := any(act).(interface{ baseAct() *Act }).baseAct()
+		for _, option := range options {
+			option(base)
+		}
+	}
+}
+
 // ============================================================================
 // Specialized Act Builders
 // ============================================================================
@@ -172,6 +509,15 @@ func NewAsk(speaker, field, prompt string, options ...AskOption) Ask {
 	return ask
 }
 
+// NewAskE creates a new Ask act like NewAsk and validates it before returning
+func NewAskE(speaker, field, prompt string, options ...AskOption) (Ask, error) {
+	ask := NewAsk(speaker, field, prompt, options...)
+	if err := ValidateAct(ask); err != nil {
+		return Ask{}, err
+	}
+	return ask, nil
+}
+
 // AskOption is a function type for configuring Ask creation
 type AskOption func(*Ask)
 
@@ -205,6 +551,15 @@ func WithMaxRetries(maxRetries int) AskOption {
 	}
 }
 
+// WithMaxRetriesE returns WithMaxRetries(maxRetries), or a ValidationError if
+// maxRetries is negative and WithMaxRetries would ignore it
+func WithMaxRetriesE(maxRetries int) (AskOption, error) {
+	if maxRetries < 0 {
+		return nil, ValidationError{Field: "max_retries", Message: "max_retries cannot be negative", Value: maxRetries}
+	}
+	return WithMaxRetries(maxRetries), nil
+}
+
 // NewFact creates a new Fact act with required fields
 func NewFact(speaker string, entity EntityRef, field string, value interface{}, options ...FactOption) Fact {
 	fact := Fact{
@@ -222,6 +577,22 @@ func NewFact(speaker string, entity EntityRef, field string, value interface{},
 	return fact
 }
 
+// NewTypedFact creates a new Fact act like NewFact, with the value's type
+// checked at compile time. The fact is otherwise identical to one built with
+// NewFact; read the value back with TypedValue.
+func NewTypedFact[T any](speaker string, entity EntityRef, field string, value T, options ...FactOption) Fact {
+	return NewFact(speaker, entity, field, value, options...)
+}
+
+// NewFactE creates a new Fact act like NewFact and validates it before returning
+func NewFactE(speaker string, entity EntityRef, field string, value interface{}, options ...FactOption) (Fact, error) {
+	fact := NewFact(speaker, entity, field, value, options...)
+	if err := ValidateAct(fact); err != nil {
+		return Fact{}, err
+	}
+	return fact, nil
+}
+
 // FactOption is a function type for configuring Fact creation
 type FactOption func(*Fact)
 
@@ -262,6 +633,15 @@ func NewConfirm(speaker string, entity EntityRef, summary string, options ...Con
 	return confirm
 }
 
+// NewConfirmE creates a new Confirm act like NewConfirm and validates it before returning
+func NewConfirmE(speaker string, entity EntityRef, summary string, options ...ConfirmOption) (Confirm, error) {
+	confirm := NewConfirm(speaker, entity, summary, options...)
+	if err := ValidateAct(confirm); err != nil {
+		return Confirm{}, err
+	}
+	return confirm, nil
+}
+
 // ConfirmOption is a function type for configuring Confirm creation
 type ConfirmOption func(*Confirm)
 
@@ -295,6 +675,15 @@ func WithTimeoutMs(timeoutMs int64) ConfirmOption {
 	}
 }
 
+// WithTimeoutMsE returns WithTimeoutMs(timeoutMs), or a ValidationError if
+// timeoutMs is negative and WithTimeoutMs would ignore it
+func WithTimeoutMsE(timeoutMs int64) (ConfirmOption, error) {
+	if timeoutMs < 0 {
+		return nil, ValidationError{Field: "timeout_ms", Message: "timeout_ms cannot be negative", Value: timeoutMs}
+	}
+	return WithTimeoutMs(timeoutMs), nil
+}
+
 // NewCommit creates a new Commit act with required fields
 func NewCommit(speaker string, entity EntityRef, action CommitAction, options ...CommitOption) Commit {
 	commit := Commit{
@@ -311,6 +700,15 @@ func NewCommit(speaker string, entity EntityRef, action CommitAction, options ..
 	return commit
 }
 
+// NewCommitE creates a new Commit act like NewCommit and validates it before returning
+func NewCommitE(speaker string, entity EntityRef, action CommitAction, options ...CommitOption) (Commit, error) {
+	commit := NewCommit(speaker, entity, action, options...)
+	if err := ValidateAct(commit); err != nil {
+		return Commit{}, err
+	}
+	return commit, nil
+}
+
 // CommitOption is a function type for configuring Commit creation
 type CommitOption func(*Commit)
 
@@ -342,6 +740,43 @@ func WithIdempotencyKey(key string) CommitOption {
 	}
 }
 
+// WithCommitError sets the error from the target system and marks the commit
+// failed. A status set by an earlier option is kept, and a later
+// WithCommitStatus overrides it, so retrying commits can carry their error too.
+func WithCommitError(code, message string, options ...CommitErrorOption) CommitOption {
+	return func(c *Commit) {
+		commitError := &CommitError{
+			Code:    code,
+			Message: message,
+		}
+		for _, option := range options {
+			option(commitError)
+		}
+		c.Error = commitError
+		if c.Status == nil {
+			status := CommitStatusFailed
+			c.Status = &status
+		}
+	}
+}
+
+// CommitErrorOption is a function type for configuring a CommitError
+type CommitErrorOption func(*CommitError)
+
+// WithCommitErrorDetails sets additional error context
+func WithCommitErrorDetails(details map[string]interface{}) CommitErrorOption {
+	return func(e *CommitError) {
+		e.Details = details
+	}
+}
+
+// WithCommitErrorRecoverable sets whether the error can be recovered from
+func WithCommitErrorRecoverable(recoverable bool) CommitErrorOption {
+	return func(e *CommitError) {
+		e.Recoverable = recoverable
+	}
+}
+
 // NewError creates a new Error act with required fields
 func NewError(speaker, code, message string, recoverable bool, options ...ErrorOption) Error {
 	errorAct := Error{
@@ -359,6 +794,15 @@ func NewError(speaker, code, message string, recoverable bool, options ...ErrorO
 	return errorAct
 }
 
+// NewErrorE creates a new Error act like NewError and validates it before returning
+func NewErrorE(speaker, code, message string, recoverable bool, options ...ErrorOption) (Error, error) {
+	errorAct := NewError(speaker, code, message, recoverable, options...)
+	if err := ValidateAct(errorAct); err != nil {
+		return Error{}, err
+	}
+	return errorAct, nil
+}
+
 // ErrorOption is a function type for configuring Error creation
 type ErrorOption func(*Error)
 
@@ -390,6 +834,39 @@ func WithUserMessage(userMessage string) ErrorOption {
 	}
 }
 
+// WithErrorDetails adds each key of details to the error's details, replacing
+// keys already set. The map is copied, so later options never modify it.
+func WithErrorDetails(details map[string]interface{}) ErrorOption {
+	return func(e *Error) {
+		for key, value := range details {
+			setErrorDetail(e, key, value)
+		}
+	}
+}
+
+// WithErrorDetail sets a single key of the error's details, creating the map
+// if needed. Keys set by other options are kept.
+func WithErrorDetail(key string, value interface{}) ErrorOption {
+	return func(e *Error) {
+		setErrorDetail(e, key, value)
+	}
+}
+
+// setErrorDetail sets a key of an error's details
+func setErrorDetail(e *Error, key string, value interface{}) {
+	if e.Details == nil {
+		e.Details = make(map[string]interface{})
+	}
+	e.Details[key] = value
+}
+
+// WithStackTrace sets the technical stack trace for debugging
+func WithStackTrace(stackTrace string) ErrorOption {
+	return func(e *Error) {
+		e.StackTrace = &stackTrace
+	}
+}
+
 // WithRelatedActID sets the ID of the act that caused this error
 func WithRelatedActID(actID string) ErrorOption {
 	return func(e *Error) {
@@ -399,6 +876,15 @@ func WithRelatedActID(actID string) ErrorOption {
 	}
 }
 
+// WithRelatedActIDE returns WithRelatedActID(actID), or a ValidationError if
+// actID is not a valid act ID and WithRelatedActID would ignore it
+func WithRelatedActIDE(actID string) (ErrorOption, error) {
+	if !IsValidActID(actID) {
+		return nil, ValidationError{Field: "related_act_id", Message: "invalid act ID format", Value: actID}
+	}
+	return WithRelatedActID(actID), nil
+}
+
 // ============================================================================
 // Entity and Participant Utilities
 // ============================================================================
@@ -535,6 +1021,10 @@ func NewConversation(participants []Participant, options ...ConversationOption)
 		Acts:         make([]ConversationAct, 0),
 	}
 	
+	// Record the schema version this package writes
+	version := SchemaVersion
+	conversation.SchemaVersion = &version
+	
 	// Set started time
 	now := time.Now()
 	conversation.StartedAt = &now
@@ -568,6 +1058,17 @@ func WithConversationSchema(schema string) ConversationOption {
 	}
 }
 
+// WithStartedAt sets when the conversation started in place of the current
+// time, for conversations imported from historical records. A zero time is
+// ignored.
+func WithStartedAt(startedAt time.Time) ConversationOption {
+	return func(c *Conversation) {
+		if !startedAt.IsZero() {
+			c.StartedAt = &startedAt
+		}
+	}
+}
+
 // WithContext sets the conversation context
 func WithContext(context ConversationContext) ConversationOption {
 	return func(c *Conversation) {
@@ -582,13 +1083,45 @@ func WithConversationStatus(status ConversationStatus) ConversationOption {
 	}
 }
 
-// AddAct adds an act to a conversation and returns the updated conversation
+// WithTimeBudget sets the conversation time budget in milliseconds
+func WithTimeBudget(budgetMs int64) ConversationOption {
+	return func(c *Conversation) {
+		if budgetMs >= 0 {
+			c.TimeBudgetMs = &budgetMs
+		}
+	}
+}
+
+// ActHook is called by AddAct with the acts already in the conversation and the
+// act being added. Returning an error rejects the act.
+type ActHook func(prev []ConversationAct, act ConversationAct) error
+
+// Use registers a hook that AddAct runs after validating each act. Hooks run in
+// registration order and the first failing hook stops the act from being added.
+func (c *Conversation) Use(hook ActHook) {
+	if hook == nil {
+		return
+	}
+	c.hooks = append(c.hooks, hook)
+}
+
+// AddAct adds an act to a conversation and returns the updated conversation.
+// The act is validated and then passed to each registered hook; if any hook
+// fails, its error is returned wrapped with the hook's index and the act is
+// not appended.
 func (c *Conversation) AddAct(act ConversationAct) error {
 	// Validate the act
 	if err := ValidateAct(act); err != nil {
 		return fmt.Errorf("invalid act: %w", err)
 	}
 	
+	// Run hooks
+	for i, hook := range c.hooks {
+		if err := hook(c.Acts, act); err != nil {
+			return fmt.Errorf("act hook %d failed: %w", i, err)
+		}
+	}
+	
 	// Add to acts slice
 	c.Acts = append(c.Acts, act)
 	
@@ -598,12 +1131,91 @@ func (c *Conversation) AddAct(act ConversationAct) error {
 	return nil
 }
 
-// EndConversation marks a conversation as ended
-func (c *Conversation) EndConversation(status ConversationStatus) {
-	now := time.Now()
-	c.EndedAt = &now
-	c.Status = &status
+// conversationTransitions lists the statuses each status may transition to.
+// Completed, failed, and cancelled are terminal.
+//
+//	active    -> paused, completed, failed, cancelled
+//	paused    -> active, cancelled
+//	completed -> (none)
+//	failed    -> (none)
+//	cancelled -> (none)
+var conversationTransitions = map[ConversationStatus][]ConversationStatus{
+	ConversationStatusActive: {
+		ConversationStatusPaused,
+		ConversationStatusCompleted,
+		ConversationStatusFailed,
+		ConversationStatusCancelled,
+	},
+	ConversationStatusPaused: {
+		ConversationStatusActive,
+		ConversationStatusCancelled,
+	},
+	ConversationStatusCompleted: {},
+	ConversationStatusFailed:    {},
+	ConversationStatusCancelled: {},
+}
+
+// CanTransition reports whether a conversation may move from one status to another.
+// Staying in the same status is always allowed.
+func CanTransition(from, to ConversationStatus) bool {
+	if _, ok := conversationTransitions[to]; !ok {
+		return false
+	}
+	if from == to {
+		return true
+	}
+	for _, allowed := range conversationTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStatus moves the conversation to the next status, rejecting transitions
+// that are not allowed by the transition matrix. A conversation without a
+// status may move to any valid status.
+func (c *Conversation) SetStatus(next ConversationStatus) error {
+	if _, ok := conversationTransitions[next]; !ok {
+		return fmt.Errorf("invalid conversation status: %s", next)
+	}
+	if c.Status != nil && !CanTransition(*c.Status, next) {
+		return fmt.Errorf("invalid conversation status transition from %s to %s", *c.Status, next)
+	}
+	c.Status = &next
+	return nil
+}
+
+// isTerminalStatus reports whether a status is one a conversation cannot leave
+func isTerminalStatus(status ConversationStatus) bool {
+	allowed, ok := conversationTransitions[status]
+	return ok && len(allowed) == 0
+}
+
+// EndConversation marks a conversation as ended with a terminal status:
+// completed, failed, or cancelled. The status change goes through SetStatus; on
+// an illegal transition, a non-terminal status, or a conversation that has
+// already ended, the conversation is left unchanged.
+func (c *Conversation) EndConversation(status ConversationStatus) error {
+	return c.EndConversationAt(status, time.Now())
+}
+
+// EndConversationAt marks a conversation as ended at the given time, for
+// conversations imported from historical records. It otherwise behaves like
+// EndConversation.
+func (c *Conversation) EndConversationAt(status ConversationStatus, endedAt time.Time) error {
+	if !isTerminalStatus(status) {
+		return fmt.Errorf("cannot end conversation with non-terminal status: %s", status)
+	}
+	if c.Status != nil && isTerminalStatus(*c.Status) {
+		return fmt.Errorf("conversation has already ended with status %s", *c.Status)
+	}
+	if err := c.SetStatus(status); err != nil {
+		return err
+	}
+	c.EndedAt = &endedAt
 	c.updateMetadata()
+	return nil
 }
 
 // updateMetadata updates the conversation metadata based on current state
@@ -659,6 +1271,23 @@ func (c *Conversation) updateMetadata() {
 	}
 }
 
+// IsOverBudget reports whether the conversation has run past its time budget.
+// Elapsed time is measured from StartedAt to EndedAt, or to now if the
+// conversation has not ended. Conversations without a budget or a start time
+// are never over budget.
+func (c Conversation) IsOverBudget(now time.Time) bool {
+	if c.TimeBudgetMs == nil || c.StartedAt == nil {
+		return false
+	}
+	
+	end := now
+	if c.EndedAt != nil {
+		end = *c.EndedAt
+	}
+	
+	return end.Sub(*c.StartedAt).Milliseconds() > *c.TimeBudgetMs
+}
+
 // GetActsByType returns all acts of a specific type from the conversation
 func (c *Conversation) GetActsByType(actType ActType) []ConversationAct {
 	var acts []ConversationAct
@@ -681,6 +1310,336 @@ func (c *Conversation) GetActsBySpeaker(speaker string) []ConversationAct {
 	return acts
 }
 
+// LastActBySpeaker returns the most recent act from a specific speaker
+func (c *Conversation) LastActBySpeaker(speaker string) (ConversationAct, bool) {
+	for i := len(c.Acts) - 1; i >= 0; i-- {
+		if c.Acts[i].GetAct().Speaker == speaker {
+			return c.Acts[i], true
+		}
+	}
+	return nil, false
+}
+
+// Turn is a contiguous run of acts by one speaker
+type Turn struct {
+	// Participant who performed every act in the turn
+	Speaker string
+	// Acts of the turn, in conversation order
+	Acts []ConversationAct
+}
+
+// Turns splits the conversation's acts into turns, starting a new turn
+// whenever the speaker differs from the previous act's. A speaker can
+// therefore have several turns, one per time they take the floor. Each turn's
+// Acts is a capacity-limited slice of the conversation's, so appending to it
+// never overwrites the conversation.
+func (c *Conversation) Turns() []Turn {
+	var turns []Turn
+	start := 0
+	for i := 1; i <= len(c.Acts); i++ {
+		if i < len(c.Acts) && c.Acts[i].GetAct().Speaker == c.Acts[start].GetAct().Speaker {
+			continue
+		}
+		turns = append(turns, Turn{
+			Speaker: c.Acts[start].GetAct().Speaker,
+			Acts:    c.Acts[start:i:i],
+		})
+		start = i
+	}
+	return turns
+}
+
+// GetActsByEntity returns all facts, confirms, and commits about an entity.
+// String and structured entity references are both matched by their ID; asks
+// and errors, which reference no entity, are never returned.
+func (c *Conversation) GetActsByEntity(entityID string) []ConversationAct {
+	var acts []ConversationAct
+	for _, act := range c.Acts {
+		if id, ok := actEntityID(act); ok && id == entityID {
+			acts = append(acts, act)
+		}
+	}
+	return acts
+}
+
+// GetActsInTimeRange returns the acts whose timestamps fall between start and
+// end, in conversation order. With inclusive set, acts at exactly start or end
+// are included; otherwise both bounds are excluded. Acts need not be in
+// chronological order: every act is checked, which costs no more than checking
+// IsChronological first would.
+func (c *Conversation) GetActsInTimeRange(start, end time.Time, inclusive bool) []ConversationAct {
+	var acts []ConversationAct
+	for _, act := range c.Acts {
+		timestamp := act.GetAct().Timestamp
+		var inRange bool
+		if inclusive {
+			inRange = !timestamp.Before(start) && !timestamp.After(end)
+		} else {
+			inRange = timestamp.After(start) && timestamp.Before(end)
+		}
+		if inRange {
+			acts = append(acts, act)
+		}
+	}
+	return acts
+}
+
+// GetCommitsByStatus returns all commits with the given status, in
+// conversation order. Commits without a status are never returned.
+func (c *Conversation) GetCommitsByStatus(status CommitStatus) []Commit {
+	var commits []Commit
+	for _, act := range c.Acts {
+		if commit, ok := act.(Commit); ok && commit.Status != nil && *commit.Status == status {
+			commits = append(commits, commit)
+		}
+	}
+	return commits
+}
+
+// GetErrorsByCategory returns all error acts in the given category, in
+// conversation order. Errors without a category are never returned.
+func (c *Conversation) GetErrorsByCategory(category ErrorCategory) []Error {
+	var errs []Error
+	for _, act := range c.Acts {
+		if errorAct, ok := act.(Error); ok && errorAct.Category != nil && *errorAct.Category == category {
+			errs = append(errs, errorAct)
+		}
+	}
+	return errs
+}
+
+// CriticalErrors returns the error acts that failed hard, in conversation
+// order: those with severity critical or that are not recoverable. An error
+// without a severity has the schema default, error, and is therefore only
+// critical when it is unrecoverable.
+func (c *Conversation) CriticalErrors() []Error {
+	var errs []Error
+	for _, act := range c.Acts {
+		errorAct, ok := act.(Error)
+		if !ok {
+			continue
+		}
+		severity := ErrorSeverityError
+		if errorAct.Severity != nil {
+			severity = *errorAct.Severity
+		}
+		if severity == ErrorSeverityCritical || !errorAct.Recoverable {
+			errs = append(errs, errorAct)
+		}
+	}
+	return errs
+}
+
+// HasUnrecoverableError reports whether the conversation contains an error act
+// after which it cannot continue, whatever that error's severity
+func (c *Conversation) HasUnrecoverableError() bool {
+	for _, act := range c.Acts {
+		if errorAct, ok := act.(Error); ok && !errorAct.Recoverable {
+			return true
+		}
+	}
+	return false
+}
+
+// CommitSuccessRate returns the fraction of the conversation's commits whose
+// status is success. Commits of every status, including those without one,
+// count towards the total. A conversation without commits has a rate of 0.
+func (c *Conversation) CommitSuccessRate() float64 {
+	total, successful := 0, 0
+	for _, act := range c.Acts {
+		commit, ok := act.(Commit)
+		if !ok {
+			continue
+		}
+		total++
+		if commit.Status != nil && *commit.Status == CommitStatusSuccess {
+			successful++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(successful) / float64(total)
+}
+
+// CompareActs orders two acts by timestamp and then, when timestamps are equal,
+// by act ID, returning a negative number when a sorts before b, a positive
+// number when it sorts after, and zero when both match. Acts with distinct IDs
+// never compare equal, so sorting with CompareActs is deterministic. A nil act
+// sorts before any other.
+func CompareActs(a, b ConversationAct) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	actA, actB := a.GetAct(), b.GetAct()
+	if c := actA.Timestamp.Compare(actB.Timestamp); c != 0 {
+		return c
+	}
+	return strings.Compare(actA.ID, actB.ID)
+}
+
+// SortActs sorts the conversation's acts in place with CompareActs
+func (c *Conversation) SortActs() {
+	slices.SortStableFunc(c.Acts, CompareActs)
+}
+
+// IsChronological reports whether the conversation's acts are ordered by
+// timestamp. Acts sharing a timestamp are considered ordered.
+func (c *Conversation) IsChronological() bool {
+	for i := 1; i < len(c.Acts); i++ {
+		if !actFollows(c.Acts[i-1], c.Acts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// actFollows reports whether next may follow previous in a chronological
+// conversation: it may share previous's timestamp but not precede it
+func actFollows(previous, next ConversationAct) bool {
+	return !next.GetAct().Timestamp.Before(previous.GetAct().Timestamp)
+}
+
+// TimestampIDMismatches returns the IDs of acts whose Timestamp differs by
+// more than tolerance from the generation time embedded in their ID, in
+// conversation order. Such acts usually have a copied or reused ID, or were
+// stamped by a skewed clock. IDs embed time to the millisecond, so the
+// Timestamp is truncated to the millisecond before comparing. Acts whose IDs
+// are not in generated form (see ParseActID) are skipped.
+func (c *Conversation) TimestampIDMismatches(tolerance time.Duration) []string {
+	var mismatched []string
+	for _, act := range c.Acts {
+		baseAct := act.GetAct()
+		parsed, err := ParseActID(baseAct.ID)
+		if err != nil {
+			continue
+		}
+		diff := baseAct.Timestamp.Truncate(time.Millisecond).Sub(parsed.Timestamp)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > tolerance {
+			mismatched = append(mismatched, baseAct.ID)
+		}
+	}
+	return mismatched
+}
+
+// GetActByID finds an act by its ID, returning an ActNotFoundError if no act matches
+func (c *Conversation) GetActByID(id string) (ConversationAct, error) {
+	for _, act := range c.Acts {
+		if act.GetAct().ID == id {
+			return act, nil
+		}
+	}
+	return nil, ActNotFoundError{ActID: id}
+}
+
+// actIndex returns the position of the act with the given ID, returning an
+// ActNotFoundError if no act matches
+func (c *Conversation) actIndex(id string) (int, error) {
+	for i, act := range c.Acts {
+		if act.GetAct().ID == id {
+			return i, nil
+		}
+	}
+	return -1, ActNotFoundError{ActID: id}
+}
+
+// ActPage returns up to limit acts starting at offset, in conversation order.
+// Out-of-range values are clamped: a negative offset starts at the first act,
+// and an offset past the end or a non-positive limit returns no acts. The
+// returned slice is a copy and may be modified freely.
+func (c *Conversation) ActPage(offset, limit int) []ConversationAct {
+	offset = max(offset, 0)
+	if limit <= 0 || offset >= len(c.Acts) {
+		return []ConversationAct{}
+	}
+	end := len(c.Acts)
+	if limit < end-offset {
+		end = offset + limit
+	}
+	return append([]ConversationAct(nil), c.Acts[offset:end]...)
+}
+
+// ActsAround returns the act with the given ID together with up to before acts
+// preceding it and up to after acts following it, in conversation order. The
+// window is truncated at the start and end of the conversation, and negative
+// counts are treated as zero. An ActNotFoundError is returned if no act
+// matches.
+func (c *Conversation) ActsAround(actID string, before, after int) ([]ConversationAct, error) {
+	index, err := c.actIndex(actID)
+	if err != nil {
+		return nil, err
+	}
+	before = min(max(before, 0), index)
+	after = min(max(after, 0), len(c.Acts))
+	start := index - before
+	return c.ActPage(start, index-start+1+after), nil
+}
+
+// RelatedActs returns all acts that reference the given act through related_act_id
+func (c *Conversation) RelatedActs(id string) []ConversationAct {
+	var acts []ConversationAct
+	for _, act := range c.Acts {
+		if relatedActID(act) == id {
+			acts = append(acts, act)
+		}
+	}
+	return acts
+}
+
+// ErrorsForAct returns all error acts whose related_act_id is the given act
+func (c *Conversation) ErrorsForAct(id string) []Error {
+	var errorActs []Error
+	for _, act := range c.Acts {
+		if errorAct, ok := act.(Error); ok && relatedActID(errorAct) == id {
+			errorActs = append(errorActs, errorAct)
+		}
+	}
+	return errorActs
+}
+
+// DanglingReferences returns the related_act_id values that do not resolve to
+// an act in the conversation, in order of first appearance
+func (c *Conversation) DanglingReferences() []string {
+	ids := make(map[string]struct{}, len(c.Acts))
+	for _, act := range c.Acts {
+		ids[act.GetAct().ID] = struct{}{}
+	}
+
+	var dangling []string
+	seen := make(map[string]struct{})
+	for _, act := range c.Acts {
+		ref := relatedActID(act)
+		if ref == "" {
+			continue
+		}
+		if _, ok := ids[ref]; ok {
+			continue
+		}
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+		seen[ref] = struct{}{}
+		dangling = append(dangling, ref)
+	}
+	return dangling
+}
+
+// relatedActID returns the act an act refers to via related_act_id, or ""
+func relatedActID(act ConversationAct) string {
+	if errorAct, ok := act.(Error); ok && errorAct.RelatedActID != nil {
+		return *errorAct.RelatedActID
+	}
+	return ""
+}
+
 // GetParticipantByID finds a participant by their ID
 func (c *Conversation) GetParticipantByID(id string) *Participant {
 	for i := range c.Participants {
@@ -691,6 +1650,515 @@ func (c *Conversation) GetParticipantByID(id string) *Participant {
 	return nil
 }
 
+// GetParticipantsByRole returns all participants with the given business role.
+// Participants without a role never match.
+func (c *Conversation) GetParticipantsByRole(role string) []Participant {
+	var participants []Participant
+	for _, participant := range c.Participants {
+		if participant.Role != nil && *participant.Role == role {
+			participants = append(participants, participant)
+		}
+	}
+	return participants
+}
+
+// GetParticipantsByType returns all participants of the given type
+func (c *Conversation) GetParticipantsByType(participantType ParticipantType) []Participant {
+	var participants []Participant
+	for _, participant := range c.Participants {
+		if participant.Type == participantType {
+			participants = append(participants, participant)
+		}
+	}
+	return participants
+}
+
+// SilentParticipants returns the participants who are not the speaker of any
+// act, in participant order
+func (c *Conversation) SilentParticipants() []Participant {
+	speakers := make(map[string]struct{}, len(c.Participants))
+	for _, act := range c.Acts {
+		speakers[act.GetAct().Speaker] = struct{}{}
+	}
+
+	var silent []Participant
+	for _, participant := range c.Participants {
+		if _, spoke := speakers[participant.ID]; !spoke {
+			silent = append(silent, participant)
+		}
+	}
+	return silent
+}
+
+// EntitiesReferenced returns the distinct IDs of the entities referenced by
+// facts, confirms, and commits, in order of first reference. String and
+// structured entity references are both resolved through GetEntityID.
+func (c *Conversation) EntitiesReferenced() []string {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, act := range c.Acts {
+		id, ok := actEntityID(act)
+		if !ok {
+			continue
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// UnansweredAsks returns the asks not followed by a fact answering them, in
+// conversation order. Answers are matched by the rules of answerAsks: a fact
+// on an entity other than the one the field's earlier asks were answered on,
+// or a fact deleting the field, does not answer an ask.
+func (c *Conversation) UnansweredAsks() []Ask {
+	answers := c.answerAsks()
+	var unanswered []Ask
+	for _, act := range c.Acts {
+		if ask, ok := act.(Ask); ok {
+			if _, answered := answers[ask.ID]; !answered {
+				unanswered = append(unanswered, ask)
+			}
+		}
+	}
+	return unanswered
+}
+
+// answerAsks matches asks to the facts answering them, returning a map from
+// ask ID to fact ID. Asks do not name an entity, so each field is bound to the
+// entity of the first fact answering an ask for it; from then on only facts
+// on that entity answer the field's asks. A pending ask is answered by the
+// first later fact setting its field on the bound entity. Facts deleting the
+// field, and facts whose entity cannot be resolved, answer nothing.
+func (c *Conversation) answerAsks() map[string]string {
+	answers := make(map[string]string)
+	pending := make(map[string][]string)
+	bound := make(map[string]string)
+
+	for _, act := range c.Acts {
+		switch a := act.(type) {
+		case Ask:
+			pending[a.Field] = append(pending[a.Field], a.ID)
+		case Fact:
+			if len(pending[a.Field]) == 0 || (a.Operation != nil && *a.Operation == FieldOperationDelete) {
+				continue
+			}
+			entityID, err := GetEntityID(a.Entity)
+			if err != nil || entityID == "" {
+				continue
+			}
+			if boundID, ok := bound[a.Field]; ok && boundID != entityID {
+				continue
+			}
+			bound[a.Field] = entityID
+			for _, askID := range pending[a.Field] {
+				answers[askID] = a.ID
+			}
+			delete(pending, a.Field)
+		}
+	}
+	return answers
+}
+
+// MatchAsksToFacts links each ask to the fact that answers it: the first later
+// fact setting the asked field on the field's entity, by the same rules as
+// UnansweredAsks. The map goes from ask ID to fact ID; asks no fact answers are
+// returned by ID in conversation order. Several asks for the same field, such
+// as a repeated prompt, are all answered by the same fact.
+func (c *Conversation) MatchAsksToFacts() (map[string]string, []string) {
+	matches := c.answerAsks()
+	var unmatched []string
+	for _, act := range c.Acts {
+		if ask, ok := act.(Ask); ok {
+			if _, matched := matches[ask.ID]; !matched {
+				unmatched = append(unmatched, ask.ID)
+			}
+		}
+	}
+	return matches, unmatched
+}
+
+// OutstandingFields returns the fields still to be collected: those asked by
+// a required ask that no fact has set since the field's first required ask,
+// in order of that ask. Once set, a field stays collected even if it is asked
+// again. Asks are required unless Required is explicitly false, matching the
+// schema default. Facts set a field by the same rules as UnansweredAsks.
+func (c *Conversation) OutstandingFields() []string {
+	answers := c.answerAsks()
+	var fields []string
+	collected := make(map[string]bool)
+
+	for _, act := range c.Acts {
+		ask, ok := act.(Ask)
+		if !ok {
+			continue
+		}
+		if _, tracked := collected[ask.Field]; !tracked {
+			if ask.Required != nil && !*ask.Required {
+				continue
+			}
+			collected[ask.Field] = false
+			fields = append(fields, ask.Field)
+		}
+		if _, answered := answers[ask.ID]; answered {
+			collected[ask.Field] = true
+		}
+	}
+
+	outstanding := fields[:0]
+	for _, field := range fields {
+		if !collected[field] {
+			outstanding = append(outstanding, field)
+		}
+	}
+	return outstanding
+}
+
+// PendingConfirmations returns the confirms still awaiting an answer, in
+// conversation order. A confirm with Awaiting set is resolved by any later
+// confirm on the same entity with Confirmed set, or by any later fact about the
+// same entity.
+func (c *Conversation) PendingConfirmations() []Confirm {
+	var pending []Confirm
+	resolved := make(map[string]struct{})
+
+	// Walk backwards so resolutions are seen before the confirms they answer
+	for i := len(c.Acts) - 1; i >= 0; i-- {
+		act := c.Acts[i]
+		entityID, hasEntity := actEntityID(act)
+
+		switch a := act.(type) {
+		case Fact:
+			if hasEntity {
+				resolved[entityID] = struct{}{}
+			}
+		case Confirm:
+			_, isResolved := resolved[entityID]
+			if a.Awaiting != nil && *a.Awaiting && !isResolved {
+				pending = append(pending, a)
+			}
+			if hasEntity && a.Confirmed != nil {
+				resolved[entityID] = struct{}{}
+			}
+		}
+	}
+
+	for i, j := 0, len(pending)-1; i < j; i, j = i+1, j-1 {
+		pending[i], pending[j] = pending[j], pending[i]
+	}
+	return pending
+}
+
+// ConfirmedFields returns the fields of an entity that an accepted confirm,
+// one with Confirmed set to true, lists in FieldsConfirmed. Rejected confirms
+// and confirms still awaiting an answer do not count, and neither do accepted
+// confirms that list no fields, since they do not say what was confirmed.
+func (c *Conversation) ConfirmedFields(entityID string) map[string]bool {
+	fields := make(map[string]bool)
+	for _, act := range c.Acts {
+		confirm, ok := act.(Confirm)
+		if !ok || !isAcceptedConfirmFor(confirm, entityID) {
+			continue
+		}
+		for _, field := range confirm.FieldsConfirmed {
+			fields[field] = true
+		}
+	}
+	return fields
+}
+
+// UnconfirmedFacts returns the facts about an entity that no later accepted
+// confirm covers, in conversation order: a fact counts as confirmed only once
+// a confirm following it lists its field, as ConfirmedFields would. A fact
+// changing a field after it was confirmed is therefore unconfirmed again. An
+// empty result means every fact about the entity has been confirmed.
+func (c *Conversation) UnconfirmedFacts(entityID string) []Fact {
+	var unconfirmed []Fact
+	confirmed := make(map[string]bool)
+
+	// Walk backwards so confirms are seen before the facts they cover
+	for i := len(c.Acts) - 1; i >= 0; i-- {
+		switch a := c.Acts[i].(type) {
+		case Confirm:
+			if isAcceptedConfirmFor(a, entityID) {
+				for _, field := range a.FieldsConfirmed {
+					confirmed[field] = true
+				}
+			}
+		case Fact:
+			if id, ok := actEntityID(a); ok && id == entityID && !confirmed[a.Field] {
+				unconfirmed = append(unconfirmed, a)
+			}
+		}
+	}
+
+	for i, j := 0, len(unconfirmed)-1; i < j; i, j = i+1, j-1 {
+		unconfirmed[i], unconfirmed[j] = unconfirmed[j], unconfirmed[i]
+	}
+	return unconfirmed
+}
+
+// isAcceptedConfirmFor reports whether a confirm about the entity was accepted
+func isAcceptedConfirmFor(confirm Confirm, entityID string) bool {
+	if confirm.Confirmed == nil || !*confirm.Confirmed {
+		return false
+	}
+	id, ok := actEntityID(confirm)
+	return ok && id == entityID
+}
+
+// LatestConfirmationFor returns the most recent confirm about the given entity.
+// Both string and structured entity references are matched by their resolved ID.
+func (c *Conversation) LatestConfirmationFor(entityID string) (*Confirm, bool) {
+	for i := len(c.Acts) - 1; i >= 0; i-- {
+		confirm, ok := c.Acts[i].(Confirm)
+		if !ok {
+			continue
+		}
+		if id, err := GetEntityID(confirm.Entity); err == nil && id == entityID {
+			return &confirm, true
+		}
+	}
+	return nil, false
+}
+
+// FactsForEntity returns all facts about the given entity in conversation order.
+// Both string and structured entity references are matched by their resolved ID.
+func (c *Conversation) FactsForEntity(entityID string) []Fact {
+	var facts []Fact
+	for _, act := range c.Acts {
+		fact, ok := act.(Fact)
+		if !ok {
+			continue
+		}
+		if id, err := GetEntityID(fact.Entity); err == nil && id == entityID {
+			facts = append(facts, fact)
+		}
+	}
+	return facts
+}
+
+// LatestFieldValue folds the facts about an entity's field in conversation order
+// with ApplyFact and returns the resulting value. A fact that ApplyFact rejects,
+// such as a non-numeric increment, is skipped and the field keeps its prior
+// value. A delete removes the value, in which case (nil, false) is returned
+// until a later fact sets it again.
+func (c *Conversation) LatestFieldValue(entityID, field string) (interface{}, bool) {
+	state := make(map[string]interface{})
+	for _, fact := range c.FactsForEntity(entityID) {
+		if fact.Field != field {
+			continue
+		}
+		_ = ApplyFact(state, fact)
+	}
+
+	value, found := state[field]
+	return value, found
+}
+
+// NewCorrectiveFact creates a set fact that replaces an entity field's current
+// value, recording that value as PreviousValue for the audit trail. The current
+// value is looked up with LatestFieldValue and deep-copied; if the field has no
+// value, PreviousValue is left nil. The fact is not added to the conversation.
+func (c *Conversation) NewCorrectiveFact(speaker, entityID, field string, newValue interface{}) Fact {
+	options := []FactOption{WithOperation(FieldOperationSet)}
+	if previous, found := c.LatestFieldValue(entityID, field); found {
+		options = append(options, WithPreviousValue(cloneValue(previous)))
+	}
+	return NewFact(speaker, entityID, field, newValue, options...)
+}
+
+// ComputeFinalState folds every fact in conversation order into the state of
+// each entity, keyed by entity ID and then field. Facts are folded the same way
+// as LatestFieldValue. The conversation's FinalState field is not modified.
+func (c *Conversation) ComputeFinalState() map[string]interface{} {
+	return foldFactState(c.Acts)
+}
+
+// StateAt returns the state of each entity right after the act with the given
+// ID, folding the facts up to and including it the same way as
+// ComputeFinalState. An ActNotFoundError is returned if no act matches.
+func (c *Conversation) StateAt(actID string) (map[string]interface{}, error) {
+	index, err := c.actIndex(actID)
+	if err != nil {
+		return nil, err
+	}
+	return foldFactState(c.Acts[:index+1]), nil
+}
+
+// foldFactState folds the facts among acts, in order, into the state of each
+// entity with ApplyFact, keyed by entity ID and then field. Facts that
+// ApplyFact rejects are skipped, leaving the field as it was.
+func foldFactState(acts []ConversationAct) map[string]interface{} {
+	state := make(map[string]interface{})
+	for _, act := range acts {
+		fact, ok := act.(Fact)
+		if !ok {
+			continue
+		}
+		entityID, err := GetEntityID(fact.Entity)
+		if err != nil || entityID == "" {
+			continue
+		}
+
+		entityState, ok := state[entityID].(map[string]interface{})
+		if !ok {
+			entityState = make(map[string]interface{})
+			state[entityID] = entityState
+		}
+		_ = ApplyFact(entityState, fact)
+	}
+	return state
+}
+
+// ApplyFact applies a single fact to the state of one entity, keyed by field.
+// Operands and targets are checked strictly and the state is left unchanged on
+// error; LatestFieldValue, ComputeFinalState, and StateAt fold facts with it,
+// skipping those it rejects:
+//
+//	set       -> the field is replaced by the fact's value
+//	increment -> value and any existing field must be numeric; a missing field counts as 0
+//	decrement -> as increment, subtracting the value
+//	append    -> the field must be missing, a slice, or a string (appending a string)
+//	merge     -> value and any existing field must be objects
+//	delete    -> the field is removed; a missing field is not an error
+//
+// Numbers are stored as float64, matching values decoded from JSON.
+func ApplyFact(state map[string]interface{}, f Fact) error {
+	if state == nil {
+		return fmt.Errorf("entity state cannot be nil")
+	}
+	if f.Field == "" {
+		return fmt.Errorf("fact field is required")
+	}
+
+	operation := FieldOperationSet
+	if f.Operation != nil {
+		operation = *f.Operation
+	}
+	current, found := state[f.Field]
+
+	switch operation {
+	case FieldOperationSet:
+		state[f.Field] = f.Value
+	case FieldOperationDelete:
+		delete(state, f.Field)
+	case FieldOperationIncrement, FieldOperationDecrement:
+		delta, ok := toFloat64(f.Value)
+		if !ok {
+			return fmt.Errorf("cannot %s field %s: value of type %T is not numeric", operation, f.Field, f.Value)
+		}
+		base := 0.0
+		if found {
+			if base, ok = toFloat64(current); !ok {
+				return fmt.Errorf("cannot %s field %s: current value of type %T is not numeric", operation, f.Field, current)
+			}
+		}
+		if operation == FieldOperationDecrement {
+			delta = -delta
+		}
+		state[f.Field] = base + delta
+	case FieldOperationAppend:
+		appended, err := appendFieldValue(current, found, f.Value)
+		if err != nil {
+			return fmt.Errorf("cannot append to field %s: %w", f.Field, err)
+		}
+		state[f.Field] = appended
+	case FieldOperationMerge:
+		value, ok := f.Value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot merge field %s: value of type %T is not an object", f.Field, f.Value)
+		}
+		currentMap, ok := current.(map[string]interface{})
+		if found && !ok {
+			return fmt.Errorf("cannot merge field %s: current value of type %T is not an object", f.Field, current)
+		}
+		merged := make(map[string]interface{}, len(currentMap)+len(value))
+		for k, v := range currentMap {
+			merged[k] = v
+		}
+		for k, v := range value {
+			merged[k] = v
+		}
+		state[f.Field] = merged
+	default:
+		return fmt.Errorf("unknown field operation: %s", operation)
+	}
+
+	return nil
+}
+
+// appendFieldValue appends value to a field holding a slice or a string,
+// returning a new value rather than modifying current in place
+func appendFieldValue(current interface{}, found bool, value interface{}) (interface{}, error) {
+	if !found {
+		return []interface{}{value}, nil
+	}
+
+	switch target := current.(type) {
+	case []interface{}:
+		appended := make([]interface{}, len(target), len(target)+1)
+		copy(appended, target)
+		return append(appended, value), nil
+	case string:
+		suffix, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of type %T cannot be appended to a string", value)
+		}
+		return target + suffix, nil
+	}
+
+	target := reflect.ValueOf(current)
+	if target.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("current value of type %T is not a slice or string", current)
+	}
+	element := reflect.ValueOf(value)
+	if !element.IsValid() || !element.Type().AssignableTo(target.Type().Elem()) {
+		return nil, fmt.Errorf("value of type %T cannot be appended to %T", value, current)
+	}
+	appended := reflect.MakeSlice(target.Type(), target.Len(), target.Len()+1)
+	reflect.Copy(appended, target)
+	return reflect.Append(appended, element).Interface(), nil
+}
+
+// toFloat64 converts any Go or JSON numeric value to float64
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
 // ============================================================================
 // Constraint Utilities
 // ============================================================================
@@ -726,6 +2194,31 @@ func WithConstraintMessage(message string) ConstraintOption {
 	}
 }
 
+// withBuilderMessage sets the constraint message to a catalog message,
+// rendered in the locale set with SetMessageLocale. It must follow
+// WithConstraintValue, whose value supplies the message's arguments.
+func withBuilderMessage(key MessageKey) ConstraintOption {
+	return func(c *Constraint) {
+		text := LocalizeMessage("", key, builderMessageArgs(key, c.Value)...)
+		c.Message = &text
+		c.builderMessage = &builderMessage{key: key, text: text}
+	}
+}
+
+// builderMessageArgs returns the arguments of a builder message, taken from
+// the constraint value
+func builderMessageArgs(key MessageKey, value interface{}) []interface{} {
+	switch key {
+	case MessageMinLength, MessageMaxLength:
+		if limit, ok := toFloat64(value); ok {
+			return []interface{}{int(limit)}
+		}
+	case MessageEnum:
+		return []interface{}{value}
+	}
+	return nil
+}
+
 // WithCode sets the constraint error code
 func WithConstraintCode(code string) ConstraintOption {
 	return func(c *Constraint) {
@@ -733,47 +2226,49 @@ func WithConstraintCode(code string) ConstraintOption {
 	}
 }
 
-// Common constraint builders
+// Common constraint builders. Their messages are in the locale set with
+// SetMessageLocale, and are rendered again in the locale passed to
+// EvaluateConstraintsInLocale.
 
 // RequiredConstraint creates a required field constraint
 func RequiredConstraint() Constraint {
 	return NewConstraint(ConstraintTypeRequired,
-		WithConstraintMessage("This field is required"))
+		withBuilderMessage(MessageRequired))
 }
 
 // MinLengthConstraint creates a minimum length constraint
 func MinLengthConstraint(minLength int) Constraint {
 	return NewConstraint(ConstraintTypeMinLength,
 		WithConstraintValue(minLength),
-		WithConstraintMessage(fmt.Sprintf("Minimum length is %d characters", minLength)))
+		withBuilderMessage(MessageMinLength))
 }
 
 // MaxLengthConstraint creates a maximum length constraint
 func MaxLengthConstraint(maxLength int) Constraint {
 	return NewConstraint(ConstraintTypeMaxLength,
 		WithConstraintValue(maxLength),
-		WithConstraintMessage(fmt.Sprintf("Maximum length is %d characters", maxLength)))
+		withBuilderMessage(MessageMaxLength))
 }
 
 // EmailFormatConstraint creates an email format constraint
 func EmailFormatConstraint() Constraint {
 	return NewConstraint(ConstraintTypeFormat,
 		WithConstraintValue(FormatTypeEmail),
-		WithConstraintMessage("Must be a valid email address"))
+		withBuilderMessage(MessageEmailFormat))
 }
 
 // PhoneFormatConstraint creates a phone format constraint
 func PhoneFormatConstraint() Constraint {
 	return NewConstraint(ConstraintTypeFormat,
 		WithConstraintValue(FormatTypePhone),
-		WithConstraintMessage("Must be a valid phone number"))
+		withBuilderMessage(MessagePhoneFormat))
 }
 
 // EnumConstraint creates an enumeration constraint
 func EnumConstraint(values []string) Constraint {
 	return NewConstraint(ConstraintTypeEnum,
 		WithConstraintValue(values),
-		WithConstraintMessage(fmt.Sprintf("Must be one of: %v", values)))
+		withBuilderMessage(MessageEnum))
 }
 
 // RangeConstraint creates a numeric range constraint
@@ -786,5 +2281,5 @@ func RangeConstraint(min, max *float64, inclusive bool) Constraint {
 	
 	return NewConstraint(ConstraintTypeRange,
 		WithConstraintValue(rangeValue),
-		WithConstraintMessage("Value must be within the specified range"))
+		withBuilderMessage(MessageRange))
 }
@@ -0,0 +1,126 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ============================================================================
+// Schema Versioning and Migration
+// ============================================================================
+
+// MigrationFunc transforms a conversation JSON document written against one
+// schema version into a document for the next. Migrations are responsible for
+// updating the document's schema_version field.
+type MigrationFunc func(data []byte) ([]byte, error)
+
+// migrationKey identifies a registered migration step
+type migrationKey struct {
+	from string
+	to   string
+}
+
+// migrations holds the registered migration steps keyed by version pair
+var (
+	migrationsMu sync.RWMutex
+	migrations   = map[migrationKey]MigrationFunc{
+		{from: "v1", to: "v1"}: migrateIdentity,
+	}
+)
+
+// RegisterMigration registers a migration from one schema version to another,
+// replacing any migration previously registered for the same pair.
+// MigrateConversation chains registered steps, so each new version only needs
+// a migration from its predecessor.
+func RegisterMigration(from, to string, migrate MigrationFunc) {
+	if from == "" || to == "" || migrate == nil {
+		return
+	}
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+	migrations[migrationKey{from: from, to: to}] = migrate
+}
+
+// DetectSchemaVersion reads the top-level schema_version field of a
+// conversation JSON document. Documents without the field predate it and are
+// reported as v1.
+func DetectSchemaVersion(data []byte) (string, error) {
+	var document struct {
+		SchemaVersion *string `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return "", fmt.Errorf("failed to detect schema version: %w", err)
+	}
+	if document.SchemaVersion == nil {
+		return "v1", nil
+	}
+	if *document.SchemaVersion == "" {
+		return "", fmt.Errorf("schema_version cannot be empty")
+	}
+	return *document.SchemaVersion, nil
+}
+
+// MigrateConversation migrates a conversation JSON document from one schema
+// version to another. A direct migration is used when registered; otherwise
+// the shortest chain of registered migrations is applied in order. An error is
+// returned if no chain connects the two versions or if any step fails.
+func MigrateConversation(data []byte, from, to string) ([]byte, error) {
+	path, err := findMigrationPath(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range path {
+		migrationsMu.RLock()
+		migrate := migrations[step]
+		migrationsMu.RUnlock()
+
+		data, err = migrate(data)
+		if err != nil {
+			return nil, fmt.Errorf("migration from %s to %s failed: %w", step.from, step.to, err)
+		}
+	}
+	return data, nil
+}
+
+// findMigrationPath returns the shortest sequence of registered migrations
+// leading from one version to another
+func findMigrationPath(from, to string) ([]migrationKey, error) {
+	migrationsMu.RLock()
+	defer migrationsMu.RUnlock()
+
+	if _, ok := migrations[migrationKey{from: from, to: to}]; ok {
+		return []migrationKey{{from: from, to: to}}, nil
+	}
+
+	previous := map[string]migrationKey{}
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		version := queue[0]
+		queue = queue[1:]
+		for key := range migrations {
+			if key.from != version || visited[key.to] {
+				continue
+			}
+			visited[key.to] = true
+			previous[key.to] = key
+			if key.to == to {
+				var path []migrationKey
+				for v := to; v != from; v = previous[v].from {
+					path = append([]migrationKey{previous[v]}, path...)
+				}
+				return path, nil
+			}
+			queue = append(queue, key.to)
+		}
+	}
+
+	return nil, fmt.Errorf("no migration registered from %s to %s", from, to)
+}
+
+// migrateIdentity is the v1 to v1 migration: the document is returned as is
+func migrateIdentity(data []byte) ([]byte, error) {
+	return data, nil
+}
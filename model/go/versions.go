@@ -0,0 +1,98 @@
+package astra
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Entity Versions
+// ============================================================================
+
+// VersionConflict records a fact written against an older revision of an entity
+// than an earlier fact in the same conversation
+type VersionConflict struct {
+	EntityID string
+	// The stale fact and the entity version it was written against
+	ActID   string
+	Version string
+	// The earlier fact carrying the newest version seen before the stale one
+	LatestActID   string
+	LatestVersion string
+}
+
+func (c VersionConflict) Error() string {
+	return fmt.Sprintf("fact %s writes entity %s at version %s, but fact %s already wrote version %s",
+		c.ActID, c.EntityID, c.Version, c.LatestActID, c.LatestVersion)
+}
+
+// NewVersionedFact creates a Fact about a structured entity, stamping the entity
+// with the revision the fact was written against. The entity passed in is not
+// modified.
+func NewVersionedFact(speaker string, entity Entity, version, field string, value interface{}, options ...FactOption) Fact {
+	entity.Version = &version
+	return NewFact(speaker, entity, field, value, options...)
+}
+
+// CheckEntityVersions detects stale writes among facts about versioned
+// entities. Versions of an entity must never go backwards: walking the facts in
+// conversation order, a fact whose entity version is older than one already
+// written for the same entity ID is reported as a conflict. Repeating a version
+// is not a conflict, since several fields may be written against one revision.
+//
+// Versions compare numerically when both are integers and lexically otherwise,
+// so both counters ("7") and sortable stamps ("2025-01-15T14:30:00Z") work.
+// Facts that reference an entity by bare ID, or without a version, are skipped.
+func (c *Conversation) CheckEntityVersions() []VersionConflict {
+	type latestVersion struct {
+		actID   string
+		version string
+	}
+	latest := make(map[string]latestVersion)
+
+	var conflicts []VersionConflict
+	for _, act := range c.Acts {
+		fact, ok := act.(Fact)
+		if !ok {
+			continue
+		}
+		entity, err := NormalizeEntityRef(fact.Entity)
+		if err != nil || entity.ID == "" || entity.Version == nil {
+			continue
+		}
+		version := *entity.Version
+
+		previous, seen := latest[entity.ID]
+		switch {
+		case !seen || compareVersions(version, previous.version) > 0:
+			latest[entity.ID] = latestVersion{actID: fact.ID, version: version}
+		case compareVersions(version, previous.version) < 0:
+			conflicts = append(conflicts, VersionConflict{
+				EntityID:      entity.ID,
+				ActID:         fact.ID,
+				Version:       version,
+				LatestActID:   previous.actID,
+				LatestVersion: previous.version,
+			})
+		}
+	}
+	return conflicts
+}
+
+// compareVersions orders two entity versions, numerically when both are
+// integers and lexically otherwise
+func compareVersions(a, b string) int {
+	x, errA := strconv.ParseInt(a, 10, 64)
+	y, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,124 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ============================================================================
+// YAML Marshaling and Unmarshaling helpers
+// ============================================================================
+//
+// yaml.v3 does not invoke MarshalJSON/UnmarshalJSON, so the additional
+// properties on metadata types and the polymorphic Conversation.Acts would be
+// lost if the structs were handed to the YAML library directly. These helpers
+// route through the JSON machinery instead: values are converted to their JSON
+// form first, and YAML documents are converted to JSON before being decoded.
+
+// MarshalActYAML marshals any ConversationAct to YAML
+func MarshalActYAML(act ConversationAct) ([]byte, error) {
+	return marshalYAMLViaJSON(act)
+}
+
+// UnmarshalActYAML unmarshals YAML to the appropriate ConversationAct type
+func UnmarshalActYAML(data []byte) (ConversationAct, error) {
+	jsonData, err := yamlToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalAct(jsonData)
+}
+
+// MarshalConversationYAML marshals a Conversation to YAML
+func MarshalConversationYAML(c Conversation) ([]byte, error) {
+	return marshalYAMLViaJSON(c)
+}
+
+// UnmarshalConversationYAML unmarshals YAML to a Conversation
+func UnmarshalConversationYAML(data []byte) (Conversation, error) {
+	var c Conversation
+
+	jsonData, err := yamlToJSON(data)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(jsonData, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// marshalYAMLViaJSON marshals a value to JSON and re-encodes the result as YAML
+func marshalYAMLViaJSON(v interface{}) ([]byte, error) {
+	jsonData, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(jsonData, &generic); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(generic)
+}
+
+// yamlToJSON decodes a YAML document and re-encodes it as JSON
+func yamlToJSON(data []byte) ([]byte, error) {
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	converted, err := convertYAMLValue(generic)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(converted)
+}
+
+// convertYAMLValue converts decoded YAML into values encoding/json can marshal.
+// YAML allows non-string mapping keys, which JSON does not.
+func convertYAMLValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			convertedItem, err := convertYAMLValue(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[key] = convertedItem
+		}
+		return converted, nil
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported YAML mapping key %v of type %T", key, key)
+			}
+			convertedItem, err := convertYAMLValue(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[keyString] = convertedItem
+		}
+		return converted, nil
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			convertedItem, err := convertYAMLValue(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = convertedItem
+		}
+		return converted, nil
+	default:
+		return v, nil
+	}
+}